@@ -24,6 +24,12 @@ for DIR in $DIRS; do
 
     ./build.sh
 
+    # Go assets that can't be built in every configuration (i.e. plugins) opt out of
+    # the variants with a no_variants file
+    if ls *.go > /dev/null 2>&1 && [ ! -f no_variants ]; then
+        ../go_variants.sh
+    fi
+
     popd > /dev/null
     echo ''
 done
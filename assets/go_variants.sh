@@ -0,0 +1,103 @@
+#!/usr/bin/env bash
+
+#
+# Builds additional variants of the Go asset in the current directory by
+# re-running its build.sh with a different environment. Each variant is written
+# next to the primary `out` binary, and is cleaned up by the asset's clean.sh.
+#
+#   out                    -gcflags="all=-N -l" (the asset's default build)
+#   out_opt                default compiler optimizations (inlining, registerized locals, etc.)
+#   out_stripped           fully stripped, with a .gnu_debuglink pointing at out_stripped.debug
+#   out_stripped.debug     the debug info extracted from out (objcopy --only-keep-debug)
+#   out_static             non-PIE, CGO_ENABLED=0 (no shared library dependencies), skipped for cgo assets
+#   out_dynamic            non-PIE, CGO_ENABLED=1 and externally linked against libc
+#   out_pie_static         PIE, CGO_ENABLED=0, skipped for cgo assets
+#   out_pie_dynamic        PIE, CGO_ENABLED=1 and externally linked against libc
+#   out_trimpath           -trimpath, so DWARF file paths no longer match the local filesystem
+#   out_race               -race, only for the concurrency assets listed in RACE_ASSETS below
+#   out_dwarf4             DWARF 4 debug info (cgo objects are compiled with -gdwarf-4)
+#   out_dwarf5             DWARF 5 debug info, only on toolchains that have the dwarf5 experiment
+#   out_manifest           the PIE-ness, linkage, and DWARF version of each of the above, as read from the ELF
+#
+
+set -e
+
+OUT=out_opt GCFLAGS=all= ./build.sh
+
+# strip the default build and move its debug info to a companion file, the same way
+# distro packages ship their -debuginfo/-dbgsym packages
+objcopy --only-keep-debug out out_stripped.debug
+objcopy --strip-all --add-gnu-debuglink=out_stripped.debug out out_stripped
+
+# assets that import "C" have no Go files left to build with cgo disabled
+CGO_ASSET=false
+if grep -qs '^import "C"' *.go; then
+    CGO_ASSET=true
+fi
+
+if [ "$CGO_ASSET" = false ]; then
+    CGO_ENABLED=0 OUT=out_static ./build.sh
+fi
+CGO_ENABLED=1 GOFLAGS="$GOFLAGS -ldflags=-linkmode=external" OUT=out_dynamic ./build.sh
+if [ "$CGO_ASSET" = false ]; then
+    CGO_ENABLED=0 GOFLAGS="$GOFLAGS -buildmode=pie" OUT=out_pie_static ./build.sh
+fi
+CGO_ENABLED=1 GOFLAGS="$GOFLAGS -buildmode=pie -ldflags=-linkmode=external" OUT=out_pie_dynamic ./build.sh
+
+# -trimpath rewrites source paths to module-relative paths (i.e. ./main.go
+# or goglobals/config/config.go), which is the fixture for developing source path substitution
+GOFLAGS="$GOFLAGS -trimpath" OUT=out_trimpath ./build.sh
+
+# the race detector instruments memory accesses with tsan, which changes stack layouts
+# and symbol names, so it's worth having race-enabled builds of the concurrency assets
+RACE_ASSETS="gocontext gohttp gosync gosyncmap gotcpserver"
+if [[ " $RACE_ASSETS " == *" $(basename $(pwd)) "* ]]; then
+    GOFLAGS="$GOFLAGS -race" OUT=out_race ./build.sh
+fi
+
+# Rather than checking the Go version, probe whether the toolchain knows about the dwarf5
+# experiment (added in Go 1.24, on by default since 1.25). If it does, DWARF 4 is forced with
+# GOEXPERIMENT=nodwarf5 and a DWARF 5 variant is built as well. If it doesn't, the toolchain
+# only emits DWARF 4. The two versions differ in line table encoding, .debug_str_offsets,
+# .debug_rnglists, etc.
+DWARF4_EXPERIMENT=""
+DWARF5_SUPPORTED=false
+if GOEXPERIMENT=dwarf5 go env GOVERSION > /dev/null 2>&1; then
+    DWARF4_EXPERIMENT=nodwarf5
+    DWARF5_SUPPORTED=true
+fi
+
+GOEXPERIMENT="${GOEXPERIMENT:+$GOEXPERIMENT,}$DWARF4_EXPERIMENT" \
+    CGO_CFLAGS="${CGO_CFLAGS:--O2 -g} -gdwarf-4" OUT=out_dwarf4 ./build.sh
+if [ "$DWARF5_SUPPORTED" = true ]; then
+    GOEXPERIMENT="${GOEXPERIMENT:+$GOEXPERIMENT,}dwarf5" \
+        CGO_CFLAGS="${CGO_CFLAGS:--O2 -g} -gdwarf-5" OUT=out_dwarf5 ./build.sh
+fi
+
+# record what each binary actually is rather than what we asked for, since the
+# toolchain is free to pick a different link mode (i.e. an asset that imports net
+# is dynamically linked even in its default build)
+{
+    for BIN in out out_*; do
+        if [[ "$BIN" == *.debug || "$BIN" == out_manifest ]]; then
+            continue
+        fi
+
+        PIE=false
+        if readelf -d "$BIN" 2> /dev/null | grep -q 'FLAGS_1.*PIE'; then
+            PIE=true
+        fi
+
+        NEEDED=$(readelf -d "$BIN" 2> /dev/null | grep NEEDED | sed -E 's/.*\[(.*)\]/\1/' | paste -sd, -)
+        LINKAGE=static
+        if [ -n "$NEEDED" ]; then
+            LINKAGE="dynamic ($NEEDED)"
+        fi
+
+        # the version of the first compile unit (stripped binaries have none)
+        DWARF=$(readelf --debug-dump=no-follow-links,info "$BIN" 2> /dev/null | grep -m1 -E '^\s+Version:' | awk '{print $2}')
+        DWARF=${DWARF:-none}
+
+        echo "$BIN pie=$PIE linkage=$LINKAGE dwarf=$DWARF"
+    done
+} > out_manifest
@@ -0,0 +1,68 @@
+// A program that writes terminal control sequences to stdout: SGR colors and
+// styles, a carriage-return-updated progress bar, cursor movement and line
+// clearing, and long lines without a trailing newline
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	reset     = "\x1b[0m"
+	bold      = "\x1b[1m"
+	underline = "\x1b[4m"
+	red       = "\x1b[31m"
+	green     = "\x1b[32m"
+	yellow    = "\x1b[33m"
+	blue      = "\x1b[34m"
+	bgMagenta = "\x1b[45m"
+	clearLine = "\x1b[2K"
+	cursorUp  = "\x1b[1A"
+)
+
+//go:noinline
+func progress(label string, steps int) {
+	const width = 40
+	for step := 0; step <= steps; step++ {
+		filled := width * step / steps
+		bar := strings.Repeat("=", filled) + strings.Repeat(" ", width-filled)
+		fmt.Printf("\r%s [%s] %3d%%", label, bar, 100*step/steps) // sim:goansiterm stops here (progress bar)
+		time.Sleep(20 * time.Millisecond)
+	}
+	fmt.Println()
+}
+
+func main() {
+	fmt.Println(red + "red" + reset + " " + green + "green" + reset + " " + yellow + "yellow" + reset + " " + blue + "blue" + reset)
+	fmt.Println(bold + "bold" + reset + " " + underline + "underline" + reset + " " + bgMagenta + bold + "bold on magenta" + reset)
+
+	// 256-color and truecolor sequences
+	for ndx := 0; ndx < 16; ndx++ {
+		fmt.Printf("\x1b[38;5;%dm#%s", 196+ndx, reset)
+	}
+	fmt.Printf(" \x1b[38;2;255;128;0mtruecolor orange%s\n", reset)
+
+	progress("downloading", 50)
+	progress("installing ", 25)
+
+	// overwrite the previous line in place
+	fmt.Println("this line will be replaced")
+	fmt.Print(cursorUp + clearLine + "\rreplaced" + "\n")
+
+	// an unterminated color that leaks into the next write
+	fmt.Print(green + "unterminated green... ")
+	fmt.Println("still green?" + reset)
+
+	// partial lines written in pieces, and a long line with no trailing newline
+	for _, word := range []string{"partial ", "line ", "written ", "in ", "pieces"} {
+		fmt.Print(word)
+		time.Sleep(50 * time.Millisecond)
+	}
+	fmt.Println()
+
+	fmt.Fprint(os.Stderr, red+"stderr in red"+reset+"\n")
+	fmt.Print(strings.Repeat("0123456789", 50)) // sim:goansiterm stops here (no trailing newline)
+}
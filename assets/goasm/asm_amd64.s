@@ -0,0 +1,35 @@
+#include "textflag.h"
+
+// func sumScaled(vals []int64, scale int64) int64
+//
+// Keeps its running sum and loop index in SP-relative locals rather than
+// registers, so the frame has a non-trivial layout.
+TEXT ·sumScaled(SB), NOSPLIT, $16-40
+	MOVQ $0, sum-8(SP)
+	MOVQ $0, ndx-16(SP)
+	MOVQ vals_base+0(FP), SI
+	MOVQ vals_len+8(FP), CX
+
+loop:
+	MOVQ ndx-16(SP), AX
+	CMPQ AX, CX
+	JGE done
+	MOVQ (SI)(AX*8), DX // sim:goasm stops here (inside assembly loop)
+	IMULQ scale+24(FP), DX
+	ADDQ DX, sum-8(SP)
+	INCQ ndx-16(SP)
+	JMP loop
+
+done:
+	MOVQ sum-8(SP), AX
+	MOVQ AX, ret+32(FP)
+	RET
+
+// func addLeaf(a, b int64) int64
+//
+// A leaf function with no frame at all (no prologue, no saved frame pointer).
+TEXT ·addLeaf(SB), NOSPLIT|NOFRAME, $0-24
+	MOVQ a+0(FP), AX
+	ADDQ b+8(FP), AX
+	MOVQ AX, ret+16(FP)
+	RET
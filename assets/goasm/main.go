@@ -0,0 +1,24 @@
+// A program that calls hand-written Go assembly functions (see asm_amd64.s):
+// one with SP-relative locals and one frameless leaf function
+package main
+
+import "log"
+
+// implemented in asm_amd64.s
+func sumScaled(vals []int64, scale int64) int64
+
+// implemented in asm_amd64.s
+func addLeaf(a, b int64) int64
+
+//go:noinline
+func callAsm(vals []int64) int64 {
+	scaled := sumScaled(vals, 3)
+	added := addLeaf(scaled, 100)
+	return added // sim:goasm stops here (after returning from assembly)
+}
+
+func main() {
+	vals := []int64{1, 2, 3, 4, 5}
+	res := callAsm(vals)
+	log.Printf("sumScaled(%v, 3) + 100 = %d", vals, res)
+}
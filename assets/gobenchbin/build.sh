@@ -0,0 +1,9 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): this asset is a benchmark binary compiled with `go test -c`. Run it
+# for long enough to attach to with i.e. ./out -test.run '^$' -test.bench . -test.benchtime 60s
+#
+
+set -x
+go test -c -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
@@ -0,0 +1,3 @@
+module gobenchbin
+
+go 1.23
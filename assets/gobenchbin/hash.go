@@ -0,0 +1,26 @@
+// Package hash implements a couple of simple hash functions to benchmark in
+// a standalone benchmark binary compiled with `go test -c`
+package hash
+
+// FNV1a is the 64-bit FNV-1a hash
+//
+//go:noinline
+func FNV1a(data []byte) uint64 {
+	h := uint64(14695981039346656037)
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= 1099511628211 // sim:gobenchbin stops here (FNV1a)
+	}
+	return h
+}
+
+// DJB2 is Dan Bernstein's string hash
+//
+//go:noinline
+func DJB2(data []byte) uint64 {
+	h := uint64(5381)
+	for _, b := range data {
+		h = h*33 + uint64(b)
+	}
+	return h
+}
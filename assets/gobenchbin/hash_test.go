@@ -0,0 +1,44 @@
+package hash
+
+import (
+	"fmt"
+	"testing"
+)
+
+var sink uint64
+
+func BenchmarkFNV1a(b *testing.B) {
+	data := make([]byte, 1024)
+	for ndx := range data {
+		data[ndx] = byte(ndx)
+	}
+
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for ndx := 0; ndx < b.N; ndx++ {
+		sink = FNV1a(data) // sim:gobenchbin stops here (b.N loop)
+	}
+}
+
+func BenchmarkHashes(b *testing.B) {
+	hashes := []struct {
+		name string
+		fn   func([]byte) uint64
+	}{
+		{name: "fnv1a", fn: FNV1a},
+		{name: "djb2", fn: DJB2},
+	}
+
+	for _, h := range hashes {
+		for _, size := range []int{16, 256, 4096} {
+			b.Run(fmt.Sprintf("%s/%d", h.name, size), func(b *testing.B) {
+				data := make([]byte, size)
+				b.SetBytes(int64(size))
+				b.ReportAllocs()
+				for ndx := 0; ndx < b.N; ndx++ {
+					sink = h.fn(data) // sim:gobenchbin stops here (sub-benchmark)
+				}
+			})
+		}
+	}
+}
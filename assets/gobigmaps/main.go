@@ -0,0 +1,64 @@
+// A program that builds maps with hundreds of thousands of entries and then
+// stops inside a range loop over each of them
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+type Point struct {
+	X, Y int32
+}
+
+type Record struct {
+	Name  string
+	Score float64
+	Tags  []string
+}
+
+func main() {
+	const entries = 500_000
+
+	ints := make(map[int]int, entries)
+	for ndx := range entries {
+		ints[ndx] = ndx * ndx
+	}
+
+	// a map grown incrementally (no size hint) so it goes through many resizes
+	strs := map[string]Record{}
+	for ndx := range entries / 2 {
+		key := fmt.Sprintf("key-%06d", ndx)
+		strs[key] = Record{Name: key, Score: float64(ndx) / 3, Tags: []string{"a", "b"}}
+	}
+
+	points := make(map[Point]*Record, entries/4)
+	for ndx := range entries / 4 {
+		points[Point{X: int32(ndx), Y: int32(-ndx)}] = &Record{Name: "point", Score: float64(ndx)}
+	}
+
+	// delete half of the int map so many slots are tombstoned
+	for ndx := 0; ndx < entries; ndx += 2 {
+		delete(ints, ndx)
+	}
+
+	sum := 0
+	for k, v := range ints {
+		sum += k + v // sim:gobigmaps stops here (ints)
+	}
+
+	total := 0.0
+	for k, v := range strs {
+		total += v.Score // sim:gobigmaps stops here (strs)
+		_ = k
+	}
+
+	count := 0
+	for k, v := range points {
+		if int(k.X) == int(v.Score) {
+			count++ // sim:gobigmaps stops here (points)
+		}
+	}
+
+	log.Printf("ints: %d (sum %d), strs: %d (total %.0f), points: %d (count %d)", len(ints), sum, len(strs), total, len(points), count)
+}
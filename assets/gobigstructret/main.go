@@ -0,0 +1,94 @@
+// A program with functions that return structs too large for the register
+// ABI (which has 9 integer and 15 floating point result registers), so the
+// results are stored to stack slots in the caller's frame. Results are
+// returned directly, through interface methods, as multiple large results,
+// and alongside an error.
+package main
+
+import (
+	"errors"
+	"log"
+)
+
+// 16 integer words, more than the 9 integer result registers
+type Matrix struct {
+	Cells [4][4]int64
+}
+
+// mixes floats and ints, and contains an array (arrays of more than one
+// element are never register-assigned)
+type Report struct {
+	Name    string
+	Values  [12]float64
+	Count   int
+	Average float64
+	Tags    []string
+}
+
+// small enough to be returned in registers, as a point of comparison
+type Small struct {
+	A, B int
+	C    float64
+}
+
+type Builder interface {
+	Build(n int) Report
+}
+
+type reportBuilder struct {
+	prefix string
+}
+
+//go:noinline
+func identity(scale int64) Matrix {
+	var m Matrix
+	for ndx := range m.Cells {
+		m.Cells[ndx][ndx] = scale
+	}
+	return m // sim:gobigstructret stops here (Matrix)
+}
+
+//go:noinline
+func (b reportBuilder) Build(n int) Report {
+	r := Report{Name: b.prefix + "-report", Count: n, Tags: []string{"big", "ret"}}
+	sum := 0.0
+	for ndx := range r.Values {
+		r.Values[ndx] = float64(ndx*n) / 2
+		sum += r.Values[ndx]
+	}
+	r.Average = sum / float64(len(r.Values))
+	return r // sim:gobigstructret stops here (Report via interface)
+}
+
+//go:noinline
+func pair(n int) (Matrix, Report) {
+	return identity(int64(n)), reportBuilder{prefix: "pair"}.Build(n) // sim:gobigstructret stops here (multiple large results)
+}
+
+//go:noinline
+func maybe(n int) (Report, error) {
+	if n < 0 {
+		return Report{}, errors.New("negative")
+	}
+	return reportBuilder{prefix: "maybe"}.Build(n), nil
+}
+
+//go:noinline
+func small(n int) Small {
+	return Small{A: n, B: n * 2, C: float64(n) / 3} // sim:gobigstructret stops here (register result)
+}
+
+func main() {
+	m := identity(7)
+
+	var b Builder = reportBuilder{prefix: "iface"}
+	r := b.Build(3)
+
+	pm, pr := pair(5)
+	mr, err := maybe(4)
+	_, negErr := maybe(-1)
+	s := small(9)
+
+	log.Printf("m[3][3]: %d, r: %s (avg %.2f), pair: %d/%s, maybe: %s/%v, neg: %v, small: %+v",
+		m.Cells[3][3], r.Name, r.Average, pm.Cells[0][0], pr.Name, mr.Name, err, negErr, s) // sim:gobigstructret stops here
+}
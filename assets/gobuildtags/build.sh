@@ -0,0 +1,8 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): pass TAGS=fast to compile in variant_fast.go instead of variant_default.go
+#
+
+set -x
+go build -tags="${TAGS:-}" -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
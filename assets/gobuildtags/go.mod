@@ -0,0 +1,3 @@
+module gobuildtags
+
+go 1.23
@@ -0,0 +1,12 @@
+// A program whose files are included or excluded by build constraints. The
+// same function is defined in two tagged variants (only one of which is
+// compiled in), there are GOOS-specific files for linux and darwin, and one
+// file is never compiled at all.
+package main
+
+import "log"
+
+func main() {
+	result := compute(10) // sim:gobuildtags stops here
+	log.Printf("variant: %s, compute(10): %d, platform: %s", variant, result, platform())
+}
@@ -0,0 +1,10 @@
+//go:build ignore
+
+package main
+
+// excluded from every build
+
+//go:noinline
+func neverCompiled() int {
+	return 42
+}
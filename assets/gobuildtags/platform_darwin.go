@@ -0,0 +1,8 @@
+package main
+
+// never compiled into the linux binary
+
+//go:noinline
+func platform() string {
+	return "darwin"
+}
@@ -0,0 +1,6 @@
+package main
+
+//go:noinline
+func platform() string {
+	return "linux" // sim:gobuildtags stops here (linux)
+}
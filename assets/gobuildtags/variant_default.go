@@ -0,0 +1,14 @@
+//go:build !fast
+
+package main
+
+const variant = "default"
+
+//go:noinline
+func compute(n int) int {
+	total := 0
+	for ndx := range n {
+		total += ndx // sim:gobuildtags stops here (default builds)
+	}
+	return total
+}
@@ -0,0 +1,10 @@
+//go:build fast
+
+package main
+
+const variant = "fast"
+
+//go:noinline
+func compute(n int) int {
+	return n * (n - 1) / 2 // sim:gobuildtags stops here (TAGS=fast builds)
+}
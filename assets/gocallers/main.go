@@ -0,0 +1,81 @@
+// A program that captures its own stack traces with runtime.Callers and
+// runtime.Stack, including from an ordinary goroutine that reacts to SIGUSR1
+// delivered through signal.Notify (not from inside the runtime's signal
+// handler itself), and reads its own machine code through a function pointer
+// (where a debugger's software breakpoints would show up as 0xcc bytes)
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+//go:noinline
+func capturePCs() []string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var names []string
+	for {
+		frame, more := frames.Next()
+		names = append(names, fmt.Sprintf("%s:%d", frame.Function, frame.Line)) // sim:gocallers stops here (runtime.Callers)
+		if !more {
+			break
+		}
+	}
+	return names
+}
+
+//go:noinline
+func captureStack(all bool) string {
+	buf := make([]byte, 64*1024)
+	n := runtime.Stack(buf, all)
+	return string(buf[:n])
+}
+
+//go:noinline
+func nested(depth int) []string {
+	if depth == 0 {
+		return capturePCs()
+	}
+	return nested(depth - 1)
+}
+
+// reads the first bytes of a function's machine code, the same way a
+// program hashing or patching its own text would
+//
+//go:noinline
+func firstBytes(fn func() []string) []byte {
+	// a func value points to a funcval whose first word is the code pointer
+	funcval := *(*unsafe.Pointer)(unsafe.Pointer(&fn))
+	code := *(**[16]byte)(funcval)
+	return append([]byte(nil), code[:]...) // sim:gocallers stops here (reading own text)
+}
+
+func main() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGUSR1)
+	// an ordinary goroutine woken by signal.Notify once the runtime's handler
+	// has queued the signal
+	go func() {
+		for range sigs {
+			log.Printf("SIGUSR1 stack:\n%s", captureStack(false)) // sim:gocallers stops here (goroutine reacting to SIGUSR1)
+		}
+	}()
+
+	log.Printf("callers: %s", strings.Join(nested(3), " <- "))
+	log.Printf("text of capturePCs: % x", firstBytes(capturePCs))
+
+	syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+	time.Sleep(100 * time.Millisecond)
+
+	log.Printf("all goroutines:\n%s", captureStack(true))
+}
@@ -0,0 +1,41 @@
+#include <pthread.h>
+#include <stdio.h>
+
+#include "callback.h"
+
+struct thread_args {
+    int64_t id;
+    int64_t count;
+    int64_t result;
+};
+
+static int64_t c_loop(int64_t id, int64_t count) {
+    int64_t total = 0;
+    for (int64_t ndx = 0; ndx < count; ndx++) {
+        total += goCallback(id, ndx); // sim:gocgocallback stops here (C calling Go)
+    }
+    return total;
+}
+
+int64_t call_from_c(int64_t id, int64_t count) {
+    return c_loop(id, count);
+}
+
+static void *thread_main(void *arg) {
+    struct thread_args *args = arg;
+    args->result = c_loop(args->id, args->count);
+    return NULL;
+}
+
+int64_t call_from_c_thread(int64_t id, int64_t count) {
+    struct thread_args args = {.id = id, .count = count, .result = 0};
+
+    pthread_t thread;
+    if (pthread_create(&thread, NULL, thread_main, &args) != 0) {
+        perror("pthread_create");
+        return -1;
+    }
+    pthread_join(thread, NULL);
+
+    return args.result;
+}
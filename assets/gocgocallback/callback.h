@@ -0,0 +1,12 @@
+#pragma once
+
+#include <stdint.h>
+
+// implemented in Go and exported via cgo
+extern int64_t goCallback(int64_t id, int64_t value);
+
+// calls goCallback `count` times from the calling thread
+int64_t call_from_c(int64_t id, int64_t count);
+
+// spawns a new pthread that calls goCallback `count` times, and waits for it
+int64_t call_from_c_thread(int64_t id, int64_t count);
@@ -0,0 +1,3 @@
+module gocgocallback
+
+go 1.23
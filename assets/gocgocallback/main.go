@@ -0,0 +1,47 @@
+// A program that registers a Go function that's called back from C, both on
+// a thread that originally came from Go and on a thread created by C with
+// pthread_create
+package main
+
+/*
+#cgo LDFLAGS: -lpthread
+#include "callback.h"
+*/
+import "C"
+
+import (
+	"log"
+	"sync"
+)
+
+var (
+	mu    sync.Mutex
+	calls = map[int64]int{}
+)
+
+//export goCallback
+func goCallback(id, value C.int64_t) C.int64_t {
+	mu.Lock()
+	calls[int64(id)]++
+	mu.Unlock()
+
+	return value * 2 // sim:gocgocallback stops here (Go called from C)
+}
+
+func main() {
+	fromGo := C.call_from_c(1, 5)
+	fromThread := C.call_from_c_thread(2, 5)
+
+	// several Go goroutines each calling into C, which creates its own thread
+	var wg sync.WaitGroup
+	for id := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			C.call_from_c_thread(C.int64_t(10+id), 3)
+		}()
+	}
+	wg.Wait()
+
+	log.Printf("from go thread: %d, from c thread: %d, calls: %v", fromGo, fromThread, calls) // sim:gocgocallback stops here
+}
@@ -0,0 +1,80 @@
+// A program whose goroutines call into C functions that block in sleep and
+// poll, so each blocked call pins an OS thread and the runtime has to spawn
+// new Ms to keep running the remaining goroutines
+package main
+
+/*
+#include <poll.h>
+#include <unistd.h>
+
+static void blocking_sleep(unsigned int seconds) {
+	sleep(seconds); // sim:gocgothreads blocks here (sleep)
+}
+
+static int blocking_poll(int fd, int timeout_ms) {
+	struct pollfd pfd = {.fd = fd, .events = POLLIN};
+	return poll(&pfd, 1, timeout_ms); // sim:gocgothreads blocks here (poll)
+}
+*/
+import "C"
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+func main() {
+	const sleepers = 16
+	const pollers = 16
+
+	// a pipe that's never written to, so polling its read end blocks until
+	// the timeout
+	r, w, err := os.Pipe()
+	if err != nil {
+		log.Fatalf("error creating pipe: %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range sleepers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			C.blocking_sleep(3)
+		}()
+	}
+	for range pollers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			C.blocking_poll(C.int(r.Fd()), 3000)
+		}()
+	}
+
+	// pure Go goroutines still need Ms to run on while the others are in C
+	done := make(chan struct{})
+	go func() {
+		ticks := 0
+		for {
+			select {
+			case <-done:
+				log.Printf("ticker saw %d ticks", ticks)
+				return
+			case <-time.After(100 * time.Millisecond):
+				ticks++
+			}
+		}
+	}()
+
+	time.Sleep(time.Second)
+	log.Printf("%d goroutines blocked in C (pid: %d)", sleepers+pollers, os.Getpid()) // sim:gocgothreads stops here
+
+	wg.Wait()
+	close(done)
+	time.Sleep(10 * time.Millisecond)
+	log.Printf("all C calls returned after %s", time.Since(start).Round(time.Millisecond))
+}
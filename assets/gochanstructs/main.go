@@ -0,0 +1,102 @@
+// A program with buffered channels holding large struct elements, a channel
+// of channels, and a channel that's partially drained so its ring buffer
+// has wrapped (sendx/recvx are non-zero and the contents don't start at
+// index zero)
+package main
+
+import (
+	"log"
+	"time"
+)
+
+type Header struct {
+	Seq     uint64
+	Flags   uint32
+	Tag     [16]byte
+	Created time.Time
+}
+
+type Frame struct {
+	Header  Header
+	Payload [256]byte
+	Length  int
+	Trailer *Header
+}
+
+type Request struct {
+	ID    int
+	Reply chan Frame
+}
+
+//go:noinline
+func newFrame(seq uint64) Frame {
+	f := Frame{
+		Header: Header{Seq: seq, Flags: uint32(seq) << 4, Created: time.Unix(int64(seq), 0)},
+		Length: int(seq) * 10,
+	}
+	copy(f.Header.Tag[:], "frame")
+	for ndx := range f.Payload {
+		f.Payload[ndx] = byte(seq) + byte(ndx)
+	}
+	if seq%2 == 0 {
+		trailer := f.Header
+		f.Trailer = &trailer
+	}
+	return f
+}
+
+//go:noinline
+func server(requests <-chan Request) {
+	for req := range requests {
+		req.Reply <- newFrame(uint64(req.ID))
+	}
+}
+
+func main() {
+	// fill the buffered channel, then drain a few and refill so the ring
+	// buffer wraps around
+	frames := make(chan Frame, 8)
+	for ndx := range 8 {
+		frames <- newFrame(uint64(ndx))
+	}
+	for range 5 {
+		f := <-frames
+		log.Printf("drained frame %d", f.Header.Seq)
+	}
+	for ndx := 8; ndx < 11; ndx++ {
+		frames <- newFrame(uint64(ndx))
+	}
+	for range 2 {
+		<-frames
+	}
+
+	// a channel of channels, where each request carries its own reply channel
+	requests := make(chan Request, 4)
+	go server(requests)
+
+	replies := make(chan chan Frame, 4)
+	for id := range 4 {
+		reply := make(chan Frame, 1)
+		requests <- Request{ID: 100 + id, Reply: reply}
+		replies <- reply
+	}
+
+	// an unbuffered channel with a parked sender
+	blocked := make(chan Frame)
+	go func() {
+		blocked <- newFrame(999)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	log.Printf("frames: len=%d cap=%d, replies: len=%d", len(frames), cap(frames), len(replies)) // sim:gochanstructs stops here
+
+	close(replies)
+	for reply := range replies {
+		f := <-reply
+		log.Printf("reply frame %d (len %d)", f.Header.Seq, f.Length)
+	}
+	close(requests)
+
+	f := <-blocked
+	log.Printf("blocked frame %d", f.Header.Seq)
+}
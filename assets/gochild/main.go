@@ -0,0 +1,79 @@
+// A program that launches child processes via os/exec. The program re-runs
+// its own binary with different roles so every generation of the process tree
+// is a debuggable Go program:
+//
+//	parent -> child -> grandchild
+//	       -> /bin/sh (a non-Go child)
+package main
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"time"
+)
+
+//go:noinline
+func spawn(role string) error {
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(self, role)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	log.Printf("%d started %s with pid %d", os.Getpid(), role, cmd.Process.Pid) // sim:gochild stops here
+
+	return cmd.Wait()
+}
+
+func parent() {
+	if err := spawn("child"); err != nil {
+		log.Fatalf("child failed: %v", err)
+	}
+
+	out, err := exec.Command("/bin/sh", "-c", "echo shell child pid: $$").Output()
+	if err != nil {
+		log.Fatalf("shell child failed: %v", err)
+	}
+	log.Printf("%s", out)
+}
+
+func child() {
+	if err := spawn("grandchild"); err != nil {
+		log.Fatalf("grandchild failed: %v", err)
+	}
+}
+
+func grandchild() {
+	for ndx := range 3 {
+		log.Printf("grandchild (pid: %d, ppid: %d): %d", os.Getpid(), os.Getppid(), ndx)
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+func main() {
+	role := "parent"
+	if len(os.Args) > 1 {
+		role = os.Args[1]
+	}
+	log.Printf("%s running with pid %d", role, os.Getpid())
+
+	switch role {
+	case "parent":
+		parent()
+	case "child":
+		child()
+	case "grandchild":
+		grandchild()
+	default:
+		log.Fatalf("unknown role: %s", role)
+	}
+
+	log.Printf("%s done", role)
+}
@@ -0,0 +1,86 @@
+// A program that creates and finishes thousands of short-lived goroutines
+// per second, so the set of goroutine IDs changes between every stop. A few
+// long-lived goroutines stay put for comparison. It runs forever unless the
+// first argument is a number of seconds to run for:
+//
+//	$ ./out
+//	$ ./out 3
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	spawned  atomic.Int64
+	finished atomic.Int64
+)
+
+//go:noinline
+func shortLived(id int64, results chan<- int64) {
+	defer finished.Add(1)
+	v := id * id
+	if id%3 == 0 {
+		// some goroutines block briefly before finishing
+		time.Sleep(time.Millisecond)
+	}
+	results <- v // sim:gochurn stops here (short-lived goroutine)
+}
+
+//go:noinline
+func longLived(name string, done <-chan struct{}) {
+	<-done
+}
+
+func main() {
+	var duration time.Duration
+	if len(os.Args) > 1 {
+		secs, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid duration %q: %v", os.Args[1], err)
+		}
+		duration = time.Duration(secs) * time.Second
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for _, name := range []string{"stable-a", "stable-b", "stable-c"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			longLived(name, done)
+		}()
+	}
+
+	results := make(chan int64, 1024)
+	go func() {
+		for range results {
+		}
+	}()
+
+	start := time.Now()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			log.Printf("spawned: %d, finished: %d (pid: %d)", spawned.Load(), finished.Load(), os.Getpid()) // sim:gochurn stops here
+			if duration > 0 && time.Since(start) >= duration {
+				close(done)
+				wg.Wait()
+				return
+			}
+		default:
+			// spawn a batch, then yield briefly
+			for range 100 {
+				go shortLived(spawned.Add(1), results)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
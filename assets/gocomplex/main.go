@@ -0,0 +1,46 @@
+// A program that declares complex numbers in locals, arrays, and struct fields
+package main
+
+import "log"
+
+type Impedance struct {
+	Name string
+	Z    complex128
+}
+
+type Signal struct {
+	Samples [4]complex64
+	Peak    complex128
+	Phasors []complex128
+}
+
+func main() {
+	a := complex64(complex(1.5, -2.5))
+	b := complex128(complex(3.25, 4.75))
+	c := complex(0, 1) // i
+	d := complex128(0)
+
+	e := [3]complex64{1 + 1i, 2 - 2i, -3 + 0.5i}
+	f := []complex128{complex(1e10, -1e-10), -1i, 42}
+
+	g := Impedance{
+		Name: "resistor+inductor",
+		Z:    complex(50, 31.4),
+	}
+
+	h := Signal{
+		Samples: [4]complex64{0, 1i, -1, -1i},
+		Peak:    complex(1, 1),
+		Phasors: []complex128{complex(0.5, 0.5), complex(-0.5, 0.5)},
+	}
+
+	log.Printf("a: %v", a) // sim:gocomplex stops here
+	log.Printf("b: %v", b)
+	log.Printf("c: %v", c)
+	log.Printf("d: %v", d)
+	log.Printf("e: %v", e)
+	log.Printf("f: %v", f)
+	log.Printf("g: %v", g)
+	log.Printf("h: %v", h)
+	log.Printf("real(b): %v, imag(b): %v", real(b), imag(b))
+}
@@ -0,0 +1,59 @@
+// A program that calls one small function millions of times with an
+// incrementing argument, with distinctive state at a few known iterations,
+// as a target for conditional and hit-count breakpoints. Pass the number of
+// iterations as the first argument (default 5,000,000):
+//
+//	$ ./out
+//	$ ./out 100000000
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+type State struct {
+	Iteration int
+	Marker    string
+	Flags     uint8
+}
+
+// iterations that set a distinctive marker, so a breakpoint condition like
+// `ndx == 1234567` can be checked against the state it observes
+var markers = map[int]string{
+	1_000:     "first",
+	1_234_567: "magic",
+	4_999_999: "last",
+}
+
+//go:noinline
+func step(ndx int, state *State) int {
+	state.Iteration = ndx // sim:gocondbp stops here (i.e. condition ndx == 1234567)
+	if marker, ok := markers[ndx]; ok {
+		state.Marker = marker
+		state.Flags |= 1 << (len(marker) % 8)
+	}
+	return ndx & 0xff
+}
+
+func main() {
+	iterations := 5_000_000
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid iteration count %q: %v", os.Args[1], err)
+		}
+		iterations = n
+	}
+
+	start := time.Now()
+	var state State
+	sum := 0
+	for ndx := range iterations {
+		sum += step(ndx, &state)
+	}
+
+	log.Printf("%d iterations in %s, sum: %d, state: %+v", iterations, time.Since(start).Round(time.Millisecond), sum, state) // sim:gocondbp stops here
+}
@@ -0,0 +1,113 @@
+// A program with iota-based enums (with and without String methods), typed
+// constants, and bitmask flag constants stored in locals
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Color is an enum with a String method
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+func (c Color) String() string {
+	switch c {
+	case Red:
+		return "Red"
+	case Green:
+		return "Green"
+	case Blue:
+		return "Blue"
+	}
+	return fmt.Sprintf("Color(%d)", int(c))
+}
+
+// Weekday is an enum without a String method that skips values and starts at 1
+type Weekday uint8
+
+const (
+	_ Weekday = iota
+	Monday
+	Tuesday
+	Wednesday
+	_
+	Friday
+)
+
+// Size uses iota in an expression
+type Size int64
+
+const (
+	_       = iota
+	KB Size = 1 << (10 * iota)
+	MB
+	GB
+)
+
+// Perm is a set of bitmask flags
+type Perm uint32
+
+const (
+	PermRead Perm = 1 << iota
+	PermWrite
+	PermExec
+	PermAdmin Perm = 0x80000000
+)
+
+func (p Perm) String() string {
+	var parts []string
+	for _, flag := range []struct {
+		perm Perm
+		name string
+	}{{PermRead, "read"}, {PermWrite, "write"}, {PermExec, "exec"}, {PermAdmin, "admin"}} {
+		if p&flag.perm != 0 {
+			parts = append(parts, flag.name)
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// typed and untyped constants
+const (
+	Pi             = 3.14159265358979323846
+	MaxConns   int = 1024
+	Greeting       = "hello"
+	Timeout        = 30 * time.Second
+	Enabled        = true
+	BigUntyped     = 1 << 62
+)
+
+func main() {
+	color := Blue
+	invalidColor := Color(99)
+	day := Wednesday
+	skipped := Friday
+	size := 3 * MB
+	perms := PermRead | PermExec
+	allPerms := PermRead | PermWrite | PermExec | PermAdmin
+	noPerms := Perm(0)
+
+	pi := Pi
+	maxConns := MaxConns
+	greeting := Greeting
+	timeout := Timeout
+	enabled := Enabled
+	big := int64(BigUntyped)
+
+	colors := []Color{Red, Green, Blue}
+	permsByUser := map[string]Perm{"alice": allPerms, "bob": PermRead}
+
+	log.Printf("color: %v, invalidColor: %v, day: %d, skipped: %d", color, invalidColor, day, skipped) // sim:goconsts stops here
+	log.Printf("size: %d, perms: %v, allPerms: %v, noPerms: %q", size, perms, allPerms, noPerms)
+	log.Printf("pi: %v, maxConns: %d, greeting: %s, timeout: %v, enabled: %v, big: %d",
+		pi, maxConns, greeting, timeout, enabled, big)
+	log.Printf("colors: %v, permsByUser: %v", colors, permsByUser)
+}
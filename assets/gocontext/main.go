@@ -0,0 +1,76 @@
+// A program that builds a tree of contexts using WithValue, WithCancel,
+// WithTimeout, and WithDeadline and hands the branches to different goroutines
+//
+//	background
+//	└── WithValue(request-id)
+//	    ├── WithCancel ──────────── worker "cancel"
+//	    │   └── WithValue(user) ─── worker "user"
+//	    └── WithTimeout ─────────── worker "timeout"
+//	        └── WithDeadline ────── worker "deadline"
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+type ctxKey string
+
+const (
+	requestIDKey ctxKey = "request-id"
+	userKey      ctxKey = "user"
+)
+
+type User struct {
+	ID   int
+	Name string
+}
+
+//go:noinline
+func worker(ctx context.Context, name string, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	reqID, _ := ctx.Value(requestIDKey).(string)
+	user, _ := ctx.Value(userKey).(*User)
+	deadline, hasDeadline := ctx.Deadline()
+	log.Printf("%s waiting (request: %s, user: %v, deadline: %v %v)", name, reqID, user, hasDeadline, deadline)
+
+	<-ctx.Done()
+	err := ctx.Err()
+	cause := context.Cause(ctx)
+	log.Printf("%s done: %v (cause: %v)", name, err, cause) // sim:gocontext stops here
+}
+
+func main() {
+	var wg sync.WaitGroup
+
+	root := context.Background()
+	reqCtx := context.WithValue(root, requestIDKey, "req-1234")
+
+	cancelCtx, cancel := context.WithCancelCause(reqCtx)
+	userCtx := context.WithValue(cancelCtx, userKey, &User{ID: 42, Name: "alice"})
+
+	timeoutCtx, cancelTimeout := context.WithTimeout(reqCtx, 2*time.Second)
+	defer cancelTimeout()
+	deadlineCtx, cancelDeadline := context.WithDeadline(timeoutCtx, time.Now().Add(time.Second))
+	defer cancelDeadline()
+
+	wg.Add(4)
+	go worker(cancelCtx, "cancel", &wg)
+	go worker(userCtx, "user", &wg)
+	go worker(timeoutCtx, "timeout", &wg)
+	go worker(deadlineCtx, "deadline", &wg)
+
+	// every worker is parked on <-ctx.Done() for the first ~second
+	time.Sleep(500 * time.Millisecond)
+	log.Printf("all workers waiting") // sim:gocontext stops here
+
+	time.Sleep(time.Second)
+	cancel(errors.New("shutting down"))
+
+	wg.Wait()
+	log.Printf("all workers done")
+}
@@ -0,0 +1,62 @@
+// A program that crashes in a way selected by its first argument:
+//
+//	$ ./out nilmap
+//	$ ./out nilptr
+//	$ ./out index
+//	$ ./out divzero
+package main
+
+import (
+	"log"
+	"os"
+)
+
+type Node struct {
+	Value int
+	Next  *Node
+}
+
+//go:noinline
+func nilMapWrite() {
+	var m map[string]int
+	m["boom"] = 1 // sim:gocrash faults here (nilmap)
+}
+
+//go:noinline
+func nilPointerDeref() int {
+	head := &Node{Value: 1}
+	return head.Next.Value // sim:gocrash faults here (nilptr)
+}
+
+//go:noinline
+func indexOutOfRange(ndx int) int {
+	values := []int{1, 2, 3}
+	return values[ndx] // sim:gocrash faults here (index)
+}
+
+//go:noinline
+func divideByZero(divisor int) int {
+	return 100 / divisor // sim:gocrash faults here (divzero)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s <nilmap|nilptr|index|divzero>", os.Args[0])
+	}
+
+	mode := os.Args[1]
+	log.Printf("crashing with mode: %s", mode)
+
+	switch mode {
+	case "nilmap":
+		nilMapWrite()
+	case "nilptr":
+		log.Println(nilPointerDeref())
+	case "index":
+		log.Println(indexOutOfRange(len(os.Args) + 5))
+	case "divzero":
+		log.Println(divideByZero(len(os.Args) - 2))
+	default:
+		log.Fatalf("unknown crash mode: %s", mode)
+	}
+}
@@ -0,0 +1,84 @@
+// Package container defines generic types that are instantiated with type
+// arguments from other packages
+package container
+
+import "fmt"
+
+// List is a generic singly linked list
+type List[T any] struct {
+	head *node[T]
+	Len  int
+}
+
+type node[T any] struct {
+	value T
+	next  *node[T]
+}
+
+//go:noinline
+func (l *List[T]) Push(v T) {
+	l.head = &node[T]{value: v, next: l.head}
+	l.Len++
+}
+
+//go:noinline
+func (l *List[T]) Each(fn func(T)) {
+	for n := l.head; n != nil; n = n.next {
+		fn(n.value) // sim:gocrosspkggenerics stops here (List.Each)
+	}
+}
+
+// Pair holds two values of possibly different types
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Index is a generic map wrapper keyed by a comparable type
+type Index[K comparable, V any] struct {
+	items map[K]V
+	order []K
+}
+
+func NewIndex[K comparable, V any]() *Index[K, V] {
+	return &Index[K, V]{items: map[K]V{}}
+}
+
+//go:noinline
+func (ix *Index[K, V]) Put(k K, v V) {
+	if _, ok := ix.items[k]; !ok {
+		ix.order = append(ix.order, k)
+	}
+	ix.items[k] = v
+}
+
+//go:noinline
+func (ix *Index[K, V]) Pairs() []Pair[K, V] {
+	pairs := make([]Pair[K, V], 0, len(ix.order))
+	for _, k := range ix.order {
+		pairs = append(pairs, Pair[K, V]{Key: k, Value: ix.items[k]})
+	}
+	return pairs // sim:gocrosspkggenerics stops here (Index.Pairs)
+}
+
+// Map applies fn to each element of in
+//
+//go:noinline
+func Map[T, U any](in []T, fn func(T) U) []U {
+	out := make([]U, 0, len(in))
+	for _, v := range in {
+		out = append(out, fn(v))
+	}
+	return out
+}
+
+// Describe works with any type that implements fmt.Stringer
+//
+//go:noinline
+func Describe[T fmt.Stringer](items ...T) string {
+	s := ""
+	for _, item := range items {
+		s += item.String() + ";"
+	}
+	return s
+}
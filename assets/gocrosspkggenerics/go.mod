@@ -0,0 +1,3 @@
+module gocrosspkggenerics
+
+go 1.23
@@ -0,0 +1,53 @@
+// A program where generic types and functions defined in package container
+// are instantiated with value, pointer, and interface type arguments from
+// package shapes and from the standard library
+package main
+
+import (
+	"log"
+	"time"
+
+	"gocrosspkggenerics/container"
+	"gocrosspkggenerics/shapes"
+)
+
+func main() {
+	// value type argument from another package
+	var circles container.List[shapes.Circle]
+	circles.Push(shapes.Circle{Radius: 1})
+	circles.Push(shapes.Circle{Radius: 2.5})
+
+	// pointer type argument
+	var rects container.List[*shapes.Rect]
+	rects.Push(&shapes.Rect{W: 2, H: 3})
+	rects.Push(&shapes.Rect{W: 4, H: 5})
+
+	// interface type argument
+	var all container.List[shapes.Shape]
+	all.Push(shapes.Circle{Radius: 3})
+	all.Push(&shapes.Rect{W: 1, H: 1})
+
+	// standard library type argument
+	var durations container.List[time.Duration]
+	durations.Push(time.Second)
+
+	total := 0.0
+	circles.Each(func(c shapes.Circle) { total += c.Area() })
+	rects.Each(func(r *shapes.Rect) { total += r.Area() })
+	all.Each(func(s shapes.Shape) { total += s.Area() })
+	durations.Each(func(d time.Duration) { total += d.Seconds() })
+
+	byID := container.NewIndex[shapes.ID, shapes.Shape]()
+	byID.Put(1, shapes.Circle{Radius: 1})
+	byID.Put(2, &shapes.Rect{W: 2, H: 2})
+	pairs := byID.Pairs()
+
+	byName := container.NewIndex[string, *shapes.Rect]()
+	byName.Put("square", &shapes.Rect{W: 3, H: 3})
+	named := byName.Pairs()
+
+	areas := container.Map([]shapes.Shape{shapes.Circle{Radius: 2}, &shapes.Rect{W: 2, H: 4}}, shapes.Shape.Area)
+	desc := container.Describe(shapes.Circle{Radius: 4}, shapes.Circle{Radius: 5})
+
+	log.Printf("total: %.2f, pairs: %d, named: %d, areas: %v, desc: %s", total, len(pairs), len(named), areas, desc) // sim:gocrosspkggenerics stops here
+}
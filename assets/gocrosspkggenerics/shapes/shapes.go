@@ -0,0 +1,30 @@
+// Package shapes defines the concrete types used as type arguments to the
+// generic containers in package container
+package shapes
+
+import (
+	"fmt"
+	"math"
+)
+
+type Shape interface {
+	Area() float64
+	fmt.Stringer
+}
+
+type Circle struct {
+	Radius float64
+}
+
+func (c Circle) Area() float64  { return math.Pi * c.Radius * c.Radius }
+func (c Circle) String() string { return fmt.Sprintf("circle(%.1f)", c.Radius) }
+
+type Rect struct {
+	W, H float64
+}
+
+func (r *Rect) Area() float64  { return r.W * r.H }
+func (r *Rect) String() string { return fmt.Sprintf("rect(%.1fx%.1f)", r.W, r.H) }
+
+// ID is a named non-struct type used as a map key
+type ID uint32
@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+
+set -x
+
+go build -gcflags="all=-N -l" -buildmode=c-shared -o libgocshared.so lib.go
+${CC:-clang} -Wall -Wextra -Werror -O0 -g -gdwarf-${DWARF:-5} -o out -L $(pwd) -Wl,-rpath=$(pwd) main.c -lgocshared
@@ -0,0 +1,7 @@
+#!/usr/bin/env bash
+
+set -x
+
+rm -f out
+rm -f *.so
+rm -f libgocshared.h
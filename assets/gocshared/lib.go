@@ -0,0 +1,52 @@
+// A Go library built with -buildmode=c-shared whose exported functions are
+// called by the C host program in main.c
+package main
+
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var (
+	mu    sync.Mutex
+	calls int
+	seen  = map[string]int{}
+)
+
+//export GoAdd
+func GoAdd(a, b C.int) C.int {
+	mu.Lock()
+	calls++
+	mu.Unlock()
+
+	sum := a + b
+	return sum // sim:gocshared stops here (GoAdd)
+}
+
+//export GoGreet
+func GoGreet(name *C.char) *C.char {
+	goName := C.GoString(name)
+
+	mu.Lock()
+	calls++
+	seen[goName]++
+	count := seen[goName]
+	mu.Unlock()
+
+	msg := fmt.Sprintf("hello from Go, %s (seen %d times)", strings.ToUpper(goName), count)
+	return C.CString(msg) // sim:gocshared stops here (GoGreet)
+}
+
+//export GoCalls
+func GoCalls() C.int {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return C.int(calls)
+}
+
+// main is required by -buildmode=c-shared, but is never called
+func main() {}
@@ -0,0 +1,22 @@
+#include <stdio.h>
+#include <stdlib.h>
+
+#include "libgocshared.h"
+
+int main() {
+    printf("C host calling in to Go\n");
+
+    int sum = GoAdd(2, 3);
+    printf("GoAdd(2, 3): %d\n", sum);
+
+    const char *names[] = {"alice", "bob", "alice"};
+    for (int i = 0; i < 3; i++) {
+        char *greeting = GoGreet((char *)names[i]);
+        printf("GoGreet: %s\n", greeting);
+        free(greeting);
+    }
+
+    printf("GoCalls: %d\n", GoCalls());
+
+    return 0;
+}
@@ -0,0 +1,82 @@
+// A program with cyclic data structures alive at a breakpoint: a circular
+// doubly linked list, a tree whose nodes point back at their parents, a
+// small object graph with cycles, and a few values that point to themselves
+package main
+
+import "log"
+
+type ListNode struct {
+	Value      int
+	Prev, Next *ListNode
+}
+
+type TreeNode struct {
+	Name     string
+	Parent   *TreeNode
+	Children []*TreeNode
+}
+
+type Person struct {
+	Name    string
+	Friends []*Person
+	Best    *Person
+	Meta    map[string]*Person
+}
+
+type SelfRef struct {
+	ID   int
+	Self *SelfRef
+	Any  any
+}
+
+//go:noinline
+func newRing(n int) *ListNode {
+	head := &ListNode{Value: 0}
+	head.Prev, head.Next = head, head
+	for ndx := 1; ndx < n; ndx++ {
+		node := &ListNode{Value: ndx, Prev: head.Prev, Next: head}
+		head.Prev.Next = node
+		head.Prev = node
+	}
+	return head
+}
+
+//go:noinline
+func newTree(name string, parent *TreeNode, depth int) *TreeNode {
+	node := &TreeNode{Name: name, Parent: parent}
+	if depth > 0 {
+		for _, suffix := range []string{"a", "b"} {
+			node.Children = append(node.Children, newTree(name+suffix, node, depth-1))
+		}
+	}
+	return node
+}
+
+func main() {
+	ring := newRing(5)
+	tree := newTree("root", nil, 3)
+
+	alice := &Person{Name: "alice"}
+	bob := &Person{Name: "bob"}
+	carol := &Person{Name: "carol"}
+	alice.Friends = []*Person{bob, carol}
+	bob.Friends = []*Person{alice}
+	carol.Friends = []*Person{alice, bob, carol}
+	alice.Best, bob.Best, carol.Best = bob, carol, alice
+	alice.Meta = map[string]*Person{"self": alice, "best": bob}
+
+	self := &SelfRef{ID: 1}
+	self.Self = self
+	self.Any = self
+
+	// a slice containing itself via an interface
+	loop := make([]any, 2)
+	loop[0] = "first"
+	loop[1] = loop
+
+	count := 0
+	for node := ring.Next; node != ring; node = node.Next {
+		count++
+	}
+	log.Printf("ring: %d, tree children: %d, alice friends: %d, self: %d, loop: %d", count+1, len(tree.Children), len(alice.Friends), self.Self.ID, len(loop)) // sim:gocyclic stops here
+}
@@ -0,0 +1,111 @@
+// A program that daemonizes itself in the traditional double-fork style, then
+// loops writing to a log file for a couple of minutes before exiting. The log
+// file path may be passed as an argument (default: /tmp/godaemon.log).
+//
+// Go can't safely fork without exec'ing, so each "fork" re-executes this
+// binary with GODAEMON_STAGE set to the next stage:
+//
+//	launcher (stage 0) -> session leader (stage 1, setsid) -> daemon (stage 2)
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	stageEnv   = "GODAEMON_STAGE"
+	iterations = 120
+)
+
+//go:noinline
+func respawn(stage, logPath string, attr *syscall.SysProcAttr) int {
+	self, err := os.Executable()
+	if err != nil {
+		log.Fatalf("failed to find executable: %v", err)
+	}
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_RDWR, 0)
+	if err != nil {
+		log.Fatalf("failed to open %s: %v", os.DevNull, err)
+	}
+	defer devNull.Close()
+
+	// os.StartProcess doesn't de-duplicate the environment, so drop the current stage
+	env := []string{stageEnv + "=" + stage}
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, stageEnv+"=") {
+			env = append(env, kv)
+		}
+	}
+
+	proc, err := os.StartProcess(self, []string{os.Args[0], logPath}, &os.ProcAttr{
+		Env:   env,
+		Files: []*os.File{devNull, devNull, devNull},
+		Sys:   attr,
+	})
+	if err != nil {
+		log.Fatalf("failed to start stage %s: %v", stage, err)
+	}
+	return proc.Pid
+}
+
+//go:noinline
+func daemon(logPath string) {
+	if err := os.Chdir("/"); err != nil {
+		os.Exit(1)
+	}
+	syscall.Umask(0o022)
+
+	f, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	cwd, _ := os.Getwd()
+	sid, _, _ := syscall.RawSyscall(syscall.SYS_GETSID, 0, 0, 0)
+	for ndx := range iterations {
+		fmt.Fprintf(f, "%s godaemon (pid: %d, ppid: %d, sid: %d, cwd: %s): %d\n",
+			time.Now().Format(time.RFC3339), os.Getpid(), os.Getppid(), sid, cwd, ndx) // sim:godaemon stops here
+		time.Sleep(time.Second)
+	}
+}
+
+func main() {
+	logPath := "/tmp/godaemon.log"
+	if len(os.Args) > 1 {
+		logPath = os.Args[1]
+	}
+
+	// the daemon changes its working directory to / before opening the log, so
+	// resolve relative paths while we're still in the caller's directory
+	logPath, err := filepath.Abs(logPath)
+	if err != nil {
+		log.Fatalf("failed to resolve log path: %v", err)
+	}
+
+	switch os.Getenv(stageEnv) {
+	case "":
+		// the launcher starts a child in a new session then exits immediately, like
+		// the parent side of the first fork
+		pid := respawn("1", logPath, &syscall.SysProcAttr{Setsid: true})
+		log.Printf("godaemon launched session leader %d, logging to %s", pid, logPath)
+
+	case "1":
+		// the session leader starts the actual daemon and exits, so the daemon is
+		// not a session leader and can never reacquire a controlling terminal
+		respawn("2", logPath, nil)
+
+	case "2":
+		daemon(logPath)
+
+	default:
+		log.Fatalf("unknown %s: %s", stageEnv, os.Getenv(stageEnv))
+	}
+}
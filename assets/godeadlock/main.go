@@ -0,0 +1,81 @@
+// A program that deliberately deadlocks after a couple of seconds: a cycle
+// of goroutines each waiting to receive from the next, two goroutines that
+// take a pair of mutexes in opposite orders, and one that locks the same
+// mutex twice. A sleeping watchdog goroutine keeps the runtime from
+// detecting the deadlock so the process stays alive to be attached to. Pass
+// "fatal" to skip the watchdog and let the runtime report the deadlock:
+//
+//	$ ./out
+//	$ ./out fatal
+package main
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+//go:noinline
+func channelCycle(id int, recv <-chan int, send chan<- int) {
+	v := <-recv // sim:godeadlock blocks here (channel cycle)
+	send <- v + id
+}
+
+//go:noinline
+func lockOrder(name string, first, second *sync.Mutex, ready *sync.WaitGroup) {
+	first.Lock()
+	ready.Done()
+	ready.Wait()
+	second.Lock() // sim:godeadlock blocks here (lock order)
+	second.Unlock()
+	first.Unlock()
+}
+
+//go:noinline
+func doubleLock(mu *sync.Mutex) {
+	mu.Lock()
+	mu.Lock() // sim:godeadlock blocks here (double lock)
+}
+
+//go:noinline
+func watchdog() {
+	for {
+		time.Sleep(time.Second)
+	}
+}
+
+func main() {
+	fatal := len(os.Args) > 1 && os.Args[1] == "fatal"
+	if !fatal {
+		go watchdog()
+	}
+
+	log.Printf("deadlocking in 2 seconds (pid: %d)", os.Getpid())
+	time.Sleep(2 * time.Second)
+
+	// each goroutine receives from its own channel and sends to the next, but
+	// nobody ever sends first
+	const cycleLen = 4
+	chans := make([]chan int, cycleLen)
+	for ndx := range chans {
+		chans[ndx] = make(chan int)
+	}
+	for ndx := range cycleLen {
+		go channelCycle(ndx, chans[ndx], chans[(ndx+1)%cycleLen])
+	}
+
+	var a, b sync.Mutex
+	var ready sync.WaitGroup
+	ready.Add(2)
+	go lockOrder("ab", &a, &b, &ready)
+	go lockOrder("ba", &b, &a, &ready)
+
+	var mu sync.Mutex
+	go doubleLock(&mu)
+
+	log.Printf("deadlocked")
+
+	// main waits on the first channel in the cycle forever
+	<-chans[0]
+}
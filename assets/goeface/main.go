@@ -0,0 +1,86 @@
+// A program that boxes every kind of value into `any` variables and an []any
+// so each eface's type word points at a different kind of runtime type
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"unsafe"
+)
+
+type Point struct {
+	X, Y int
+}
+
+type Celsius float64
+
+type Stringer interface {
+	String() string
+}
+
+func (p Point) String() string { return fmt.Sprintf("(%d, %d)", p.X, p.Y) }
+
+//go:noinline
+func describe(v any) string {
+	return fmt.Sprintf("%T", v) // sim:goeface stops here (describe)
+}
+
+func main() {
+	var (
+		aBool       any = true
+		anInt       any = int(-42)
+		anInt8      any = int8(-8)
+		anInt16     any = int16(-1600)
+		anInt32     any = int32(-320000)
+		anInt64     any = int64(-6400000000)
+		aUint       any = uint(42)
+		aUint8      any = uint8(255)
+		aUint16     any = uint16(65535)
+		aUint32     any = uint32(4294967295)
+		aUint64     any = uint64(18446744073709551615)
+		aUintptr    any = uintptr(0xdeadbeef)
+		aFloat32    any = float32(3.25)
+		aFloat64    any = float64(2.718281828)
+		aComplex64  any = complex64(complex(1, 2))
+		aComplex128 any = complex(3.5, -4.5)
+		aString     any = "hello, eface"
+		anEmptyStr  any = ""
+		aSlice      any = []int{1, 2, 3}
+		aByteSlice  any = []byte("bytes")
+		anArray     any = [3]string{"a", "b", "c"}
+		aMap        any = map[string]int{"one": 1, "two": 2}
+		aFunc       any = describe
+		aClosure    any
+		aStruct     any = Point{X: 1, Y: 2}
+		aPtr        any = &Point{X: 3, Y: 4}
+		aNamed      any = Celsius(21.5)
+		aChan       any = make(chan int, 2)
+		anErr       any = errors.New("boxed error")
+		anIface     any = Stringer(Point{X: 5, Y: 6})
+		anUnsafePtr any = unsafe.Pointer(&aBool)
+		aNilPtr     any = (*Point)(nil)
+		aNilSlice   any = []int(nil)
+		aNilMap     any = map[string]int(nil)
+		aNil        any
+	)
+
+	captured := 10
+	aClosure = func() int { return captured * 2 }
+
+	all := []any{
+		aBool, anInt, anInt8, anInt16, anInt32, anInt64,
+		aUint, aUint8, aUint16, aUint32, aUint64, aUintptr,
+		aFloat32, aFloat64, aComplex64, aComplex128,
+		aString, anEmptyStr, aSlice, aByteSlice, anArray, aMap,
+		aFunc, aClosure, aStruct, aPtr, aNamed, aChan, anErr, anIface,
+		anUnsafePtr, aNilPtr, aNilSlice, aNilMap, aNil,
+	}
+
+	nested := []any{all[:3], map[string]any{"inner": aStruct, "nil": nil}, &all[0]}
+
+	for ndx, v := range all {
+		log.Printf("%2d: %s", ndx, describe(v))
+	}
+	log.Printf("nested: %d", len(nested)) // sim:goeface stops here
+}
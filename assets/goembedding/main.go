@@ -0,0 +1,109 @@
+// A program with embedded structs, embedded pointers, embedded interfaces,
+// and anonymous struct fields whose promoted fields and methods are used
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+type Base struct {
+	ID   int
+	Name string
+}
+
+func (b Base) Describe() string {
+	return fmt.Sprintf("Base(%d, %s)", b.ID, b.Name)
+}
+
+type Timestamps struct {
+	Created int64
+	Updated int64
+}
+
+func (t *Timestamps) Touch(now int64) {
+	t.Updated = now
+}
+
+type Logger interface {
+	Log(msg string)
+}
+
+type prefixLogger struct {
+	prefix string
+}
+
+func (p prefixLogger) Log(msg string) {
+	log.Printf("%s: %s", p.prefix, msg)
+}
+
+// User embeds a value, a pointer, and an interface
+type User struct {
+	Base
+	*Timestamps
+	Logger
+
+	Email string
+}
+
+// Admin embeds a type that itself embeds, so fields are promoted two levels
+type Admin struct {
+	User
+	Level int
+
+	// anonymous struct field
+	Permissions struct {
+		Read  bool
+		Write bool
+	}
+}
+
+// Shadowing has a field with the same name as one on its embedded type
+type Shadowing struct {
+	Base
+	Name string
+}
+
+func main() {
+	user := User{
+		Base:       Base{ID: 1, Name: "alice"},
+		Timestamps: &Timestamps{Created: 100, Updated: 100},
+		Logger:     prefixLogger{prefix: "user"},
+		Email:      "alice@example.com",
+	}
+
+	admin := Admin{
+		User:  user,
+		Level: 9,
+	}
+	admin.Permissions.Read = true
+	admin.Permissions.Write = true
+
+	shadow := Shadowing{
+		Base: Base{ID: 2, Name: "inner"},
+		Name: "outer",
+	}
+
+	anon := struct {
+		Base
+		Extra []string
+	}{
+		Base:  Base{ID: 3, Name: "anonymous"},
+		Extra: []string{"a", "b"},
+	}
+
+	var nilEmbed User
+
+	// access promoted fields and methods
+	user.Touch(200)
+	id := admin.ID
+	name := admin.Name
+	created := admin.Created
+	desc := admin.Describe()
+	admin.Log("promoted through two levels") // sim:goembedding stops here
+
+	log.Printf("id: %d, name: %s, created: %d, desc: %s", id, name, created, desc)
+	log.Printf("shadow: %s / %s", shadow.Name, shadow.Base.Name)
+	log.Printf("anon: %s %v", anon.Describe(), anon.Extra)
+	log.Printf("nilEmbed: %v %v", nilEmbed.Timestamps, nilEmbed.Logger)
+}
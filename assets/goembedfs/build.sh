@@ -0,0 +1,5 @@
+#!/usr/bin/env bash
+
+set -x
+go run genblob.go -size ${BLOB_SIZE:-8388608}
+go build -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
@@ -0,0 +1,5 @@
+#!/usr/bin/env bash
+
+set -x
+rm -f out out_*
+rm -f data/blob.bin
@@ -0,0 +1,30 @@
+//go:build ignore
+
+// Generates data/blob.bin, the large binary file embedded by the goembedfs
+// asset. The contents are pseudo-random but deterministic so every build of
+// the asset embeds the same bytes.
+//
+//	$ go run genblob.go -size 8388608
+package main
+
+import (
+	"flag"
+	"log"
+	"math/rand/v2"
+	"os"
+)
+
+func main() {
+	size := flag.Int("size", 8*1024*1024, "the size of the blob in bytes")
+	flag.Parse()
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	blob := make([]byte, *size)
+	for ndx := range blob {
+		blob[ndx] = byte(rng.Uint32())
+	}
+
+	if err := os.WriteFile("data/blob.bin", blob, 0o644); err != nil {
+		log.Fatalf("failed to write blob: %v", err)
+	}
+}
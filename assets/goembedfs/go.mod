@@ -0,0 +1,3 @@
+module goembedfs
+
+go 1.23
@@ -0,0 +1,66 @@
+// A program that embeds several files via //go:embed, including a large
+// generated binary blob (see genblob.go), and reads them at runtime. The
+// blob is also a read-only string global, which shares its data with the
+// embed.FS's copy of the same file, so it's only embedded once.
+package main
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"log"
+)
+
+//go:embed data
+var data embed.FS
+
+//go:embed data/hello.txt
+var hello string
+
+//go:embed data/config.json
+var configJSON []byte
+
+//go:embed data/blob.bin
+var blob string
+
+type Config struct {
+	Name     string   `json:"name"`
+	Version  int      `json:"version"`
+	Features []string `json:"features"`
+}
+
+func main() {
+	var cfg Config
+	if err := json.Unmarshal(configJSON, &cfg); err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+
+	var paths []string
+	err := fs.WalkDir(data, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("failed to walk embedded files: %v", err)
+	}
+
+	readme, err := data.ReadFile("data/nested/readme.md")
+	if err != nil {
+		log.Fatalf("failed to read readme: %v", err)
+	}
+
+	head := blob[:min(len(blob), 64)]
+	sum := sha256.Sum256([]byte(blob))
+
+	log.Printf("hello: %q", hello) // sim:goembedfs stops here
+	log.Printf("config: %+v", cfg)
+	log.Printf("embedded files: %v", paths)
+	log.Printf("readme: %d bytes", len(readme))
+	log.Printf("blob: %d bytes, head: %x, sha256: %x", len(blob), head, sum)
+}
@@ -0,0 +1,79 @@
+// A program whose code paths depend on its arguments and environment, so
+// it's easy to see whether a launch configuration was actually applied:
+//
+//	$ ./out
+//	$ ./out -mode=fast -count=3 extra args
+//	$ GOENVARGS_GREETING=hi GOENVARGS_VERBOSE=1 ./out -mode=slow
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	Mode     string
+	Count    int
+	Verbose  bool
+	Greeting string
+	Args     []string
+	Env      []string
+}
+
+//go:noinline
+func runFast(cfg Config) {
+	log.Printf("fast mode: %s x%d", cfg.Greeting, cfg.Count) // sim:goenvargs stops here (mode=fast)
+}
+
+//go:noinline
+func runSlow(cfg Config) {
+	for ndx := range cfg.Count {
+		log.Printf("slow mode %d: %s", ndx, cfg.Greeting) // sim:goenvargs stops here (mode=slow)
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+//go:noinline
+func runDefault(cfg Config) {
+	log.Printf("default mode: %s", cfg.Greeting) // sim:goenvargs stops here (default)
+}
+
+func main() {
+	mode := flag.String("mode", "default", "one of default, fast, or slow")
+	count := flag.Int("count", 1, "how many times to greet")
+	flag.Parse()
+
+	cfg := Config{
+		Mode:     *mode,
+		Count:    *count,
+		Verbose:  os.Getenv("GOENVARGS_VERBOSE") != "",
+		Greeting: "hello",
+		Args:     flag.Args(),
+	}
+	if greeting, ok := os.LookupEnv("GOENVARGS_GREETING"); ok {
+		cfg.Greeting = greeting
+	}
+	for _, kv := range os.Environ() {
+		if strings.HasPrefix(kv, "GOENVARGS_") {
+			cfg.Env = append(cfg.Env, kv)
+		}
+	}
+
+	log.Printf("argv: %q", os.Args)
+	log.Printf("env: %q", cfg.Env)
+	if cfg.Verbose {
+		log.Printf("config: %+v", cfg) // sim:goenvargs stops here (GOENVARGS_VERBOSE set)
+	}
+
+	switch cfg.Mode {
+	case "fast":
+		runFast(cfg)
+	case "slow":
+		runSlow(cfg)
+	default:
+		runDefault(cfg)
+	}
+}
@@ -0,0 +1,9 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): golang.org/x/sync is checked in under vendor/ so this asset builds
+# without network access. Always build in vendor mode (and never try to download it).
+#
+
+set -x
+go build -mod=vendor -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
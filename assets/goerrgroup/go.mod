@@ -0,0 +1,5 @@
+module goerrgroup
+
+go 1.23
+
+require golang.org/x/sync v0.8.0
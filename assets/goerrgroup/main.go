@@ -0,0 +1,58 @@
+// A program that runs a batch of tasks under a golang.org/x/sync/errgroup
+// where some tasks fail; the first failure cancels the group's context and
+// the remaining tasks observe the cancellation
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+type Task struct {
+	ID       int
+	Duration time.Duration
+	Fail     bool
+}
+
+var errTaskFailed = errors.New("task failed")
+
+//go:noinline
+func run(ctx context.Context, task Task) error {
+	select {
+	case <-time.After(task.Duration):
+	case <-ctx.Done():
+		return fmt.Errorf("task %d cancelled: %w", task.ID, context.Cause(ctx)) // sim:goerrgroup stops here (cancelled)
+	}
+
+	if task.Fail {
+		return fmt.Errorf("task %d: %w", task.ID, errTaskFailed) // sim:goerrgroup stops here (first error)
+	}
+	log.Printf("task %d finished", task.ID)
+	return nil
+}
+
+func main() {
+	tasks := []Task{
+		{ID: 0, Duration: 10 * time.Millisecond},
+		{ID: 1, Duration: 50 * time.Millisecond, Fail: true},
+		{ID: 2, Duration: 200 * time.Millisecond},
+		{ID: 3, Duration: 100 * time.Millisecond, Fail: true},
+		{ID: 4, Duration: 500 * time.Millisecond},
+	}
+
+	group, ctx := errgroup.WithContext(context.Background())
+	group.SetLimit(4)
+	for _, task := range tasks {
+		group.Go(func() error {
+			return run(ctx, task)
+		})
+	}
+
+	err := group.Wait()
+	log.Printf("group finished: %v (cause: %v)", err, context.Cause(ctx)) // sim:goerrgroup stops here
+}
@@ -0,0 +1,91 @@
+// A program that builds several-levels-deep error chains using fmt.Errorf
+// wrapping, errors.Join, and custom error types
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type QueryError struct {
+	Query string
+	Line  int
+	Err   error
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("query %q (line %d): %v", e.Query, e.Line, e.Err)
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+type TemporaryError struct {
+	Retries int
+}
+
+func (e TemporaryError) Error() string {
+	return fmt.Sprintf("temporary failure after %d retries", e.Retries)
+}
+
+//go:noinline
+func lookup(key string) error {
+	return fmt.Errorf("lookup %s: %w", key, ErrNotFound)
+}
+
+//go:noinline
+func query(q string) error {
+	return &QueryError{Query: q, Line: 42, Err: lookup("users")}
+}
+
+//go:noinline
+func handle(q string) error {
+	if err := query(q); err != nil {
+		return fmt.Errorf("handle request: %w", err)
+	}
+	return nil
+}
+
+//go:noinline
+func serve() error {
+	err := handle("SELECT * FROM users")
+	wrapped := fmt.Errorf("serve: %w", err)
+	return wrapped // sim:goerrors stops here (deep chain)
+}
+
+//go:noinline
+func multi() error {
+	_, statErr := os.Stat("/this/path/does/not/exist")
+	joined := errors.Join(
+		serve(),
+		TemporaryError{Retries: 3},
+		fmt.Errorf("stat config: %w", statErr),
+	)
+	twoWraps := fmt.Errorf("multi: %w and %w", ErrNotFound, TemporaryError{Retries: 1})
+	outer := fmt.Errorf("shutdown: %w", errors.Join(joined, twoWraps))
+	return outer // sim:goerrors stops here (joined chain)
+}
+
+func main() {
+	err := multi()
+
+	var qe *QueryError
+	var te TemporaryError
+	var pe *fs.PathError
+	isNotFound := errors.Is(err, ErrNotFound)
+	asQuery := errors.As(err, &qe)
+	asTemp := errors.As(err, &te)
+	asPath := errors.As(err, &pe)
+	var nilErr error
+
+	log.Printf("err: %v", err)
+	log.Printf("is not found: %v, as query: %v, as temporary: %v, as path: %v",
+		isNotFound, asQuery, asTemp, asPath)
+	log.Printf("query: %v, path: %v, nil: %v", qe.Query, pe.Path, nilErr)
+}
@@ -0,0 +1,48 @@
+// A program that replaces its own image via syscall.Exec a few times, passing
+// a different generation number in argv each time. The pid stays the same
+// across every exec.
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const maxGeneration = 3
+
+func main() {
+	generation := 0
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid generation %q: %v", os.Args[1], err)
+		}
+		generation = n
+	}
+
+	log.Printf("generation %d running (pid: %d, args: %v)", generation, os.Getpid(), os.Args)
+	if generation >= maxGeneration {
+		log.Printf("final generation reached")
+		return
+	}
+
+	time.Sleep(2 * time.Second)
+
+	self, err := os.Executable()
+	if err != nil {
+		log.Fatalf("failed to find executable: %v", err)
+	}
+
+	next := strconv.Itoa(generation + 1)
+	argv := []string{self, next, "exec-from-" + strconv.Itoa(generation)}
+	env := append(os.Environ(), "GOEXECSELF_GENERATION="+next)
+	log.Printf("exec'ing %v", argv) // sim:goexecself stops here (before exec)
+
+	err = syscall.Exec(self, argv, env)
+
+	// only reached if the exec fails
+	log.Fatalf("exec failed: %v", err)
+}
@@ -0,0 +1,72 @@
+// A program that exits with a distinct code from a place selected by its
+// first argument:
+//
+//	$ ./out main      # exits 0 after returning from main
+//	$ ./out exit      # os.Exit(3) directly from main
+//	$ ./out deferred  # os.Exit(4) from a deferred function
+//	$ ./out goroutine # os.Exit(5) from a goroutine while main is blocked
+//	$ ./out fatal     # log.Fatal, which exits 1
+//	$ ./out code N    # os.Exit(N)
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+//go:noinline
+func exitWith(code int) {
+	log.Printf("exiting with code %d", code)
+	os.Exit(code) // sim:goexitcodes stops here
+}
+
+//go:noinline
+func exitFromDefer() {
+	defer func() {
+		exitWith(4) // sim:goexitcodes stops here (deferred)
+	}()
+	log.Printf("returning from exitFromDefer")
+}
+
+//go:noinline
+func exitFromGoroutine() {
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		exitWith(5) // sim:goexitcodes stops here (goroutine)
+	}()
+
+	// main never returns from here
+	select {}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s <main|exit|deferred|goroutine|fatal|code N>", os.Args[0])
+	}
+
+	switch mode := os.Args[1]; mode {
+	case "main":
+		log.Printf("returning from main")
+	case "exit":
+		exitWith(3)
+	case "deferred":
+		exitFromDefer()
+	case "goroutine":
+		exitFromGoroutine()
+	case "fatal":
+		log.Fatal("fatal exit")
+	case "code":
+		if len(os.Args) < 3 {
+			log.Fatalf("usage: %s code N", os.Args[0])
+		}
+		code, err := strconv.Atoi(os.Args[2])
+		if err != nil {
+			log.Fatalf("invalid exit code %q: %v", os.Args[2], err)
+		}
+		exitWith(code)
+	default:
+		log.Fatalf("unknown mode: %s", mode)
+	}
+}
@@ -0,0 +1,91 @@
+// A program that writes and reads back multi-megabyte buffers through temp
+// files, stopping mid-copy while large []byte buffers are in flight
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"log"
+	"os"
+)
+
+const (
+	fileSize  = 64 << 20
+	chunkSize = 4 << 20
+)
+
+//go:noinline
+func fill(buf []byte, seed byte) {
+	for ndx := range buf {
+		buf[ndx] = seed + byte(ndx*31)
+	}
+}
+
+//go:noinline
+func copyChunks(dst io.Writer, src io.Reader, buf []byte) (int64, error) {
+	var total int64
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			chunk := buf[:n]
+			if _, werr := dst.Write(chunk); werr != nil {
+				return total, werr
+			}
+			total += int64(n) // sim:gofileio stops here (mid-copy)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+func main() {
+	src, err := os.CreateTemp("", "gofileio-src-*")
+	if err != nil {
+		log.Fatalf("error creating source file: %v", err)
+	}
+	defer os.Remove(src.Name())
+	defer src.Close()
+
+	dst, err := os.CreateTemp("", "gofileio-dst-*")
+	if err != nil {
+		log.Fatalf("error creating destination file: %v", err)
+	}
+	defer os.Remove(dst.Name())
+	defer dst.Close()
+
+	// write the source in large chunks
+	chunk := make([]byte, chunkSize)
+	hasher := sha256.New()
+	for ndx := 0; ndx < fileSize/chunkSize; ndx++ {
+		fill(chunk, byte(ndx))
+		hasher.Write(chunk)
+		if _, err := src.Write(chunk); err != nil {
+			log.Fatalf("error writing %s: %v", src.Name(), err)
+		}
+	}
+	want := hasher.Sum(nil)
+
+	// copy it to the destination through an odd-sized buffer
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		log.Fatalf("error seeking %s: %v", src.Name(), err)
+	}
+	buf := make([]byte, 3<<20+17)
+	copied, err := copyChunks(dst, src, buf)
+	if err != nil {
+		log.Fatalf("error copying: %v", err)
+	}
+
+	// read the whole destination back into one buffer
+	contents, err := os.ReadFile(dst.Name())
+	if err != nil {
+		log.Fatalf("error reading %s: %v", dst.Name(), err)
+	}
+	got := sha256.Sum256(contents)
+
+	log.Printf("copied %d bytes, read back %d, hashes match: %v", copied, len(contents), bytes.Equal(want, got[:])) // sim:gofileio stops here
+}
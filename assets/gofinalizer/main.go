@@ -0,0 +1,105 @@
+// A program with objects whose finalizers are in each possible state:
+// already run, currently running (blocked inside the runtime's finalizer
+// goroutine), queued behind the running one, and registered on objects that
+// are still reachable. One finalizer resurrects its object by storing it in
+// a global.
+package main
+
+import (
+	"log"
+	"runtime"
+	"sync"
+	"time"
+)
+
+type Resource struct {
+	Name string
+	Data []byte
+}
+
+var (
+	mu          sync.Mutex
+	ran         []string
+	resurrected *Resource
+	release     = make(chan struct{})
+	running     = make(chan struct{})
+)
+
+//go:noinline
+func record(r *Resource) {
+	mu.Lock()
+	defer mu.Unlock()
+	ran = append(ran, r.Name) // sim:gofinalizer stops here (inside a finalizer)
+}
+
+func quick(r *Resource) {
+	record(r)
+}
+
+func blocking(r *Resource) {
+	close(running)
+	<-release // sim:gofinalizer blocks here (finalizer goroutine)
+	record(r)
+}
+
+func resurrect(r *Resource) {
+	record(r)
+	mu.Lock()
+	resurrected = r
+	mu.Unlock()
+}
+
+//go:noinline
+func register(name string, fn func(*Resource)) {
+	r := &Resource{Name: name, Data: make([]byte, 4096)}
+	runtime.SetFinalizer(r, fn)
+}
+
+//go:noinline
+func ranNames() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]string(nil), ran...)
+}
+
+func waitFor(n int) {
+	for deadline := time.Now().Add(2 * time.Second); len(ranNames()) < n; {
+		if time.Now().After(deadline) {
+			log.Fatalf("timed out waiting for %d finalizers, ran: %v", n, ranNames())
+		}
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func main() {
+	// reachable for the whole program, so its finalizer never runs
+	live := &Resource{Name: "live"}
+	runtime.SetFinalizer(live, quick)
+
+	// finalizers that have already run by the time of the first breakpoint
+	register("done-1", quick)
+	register("done-2", resurrect)
+	waitFor(2)
+
+	// the finalizer goroutine runs one finalizer at a time, so the others queue
+	// up behind the one that blocks
+	register("blocking", blocking)
+	runtime.GC()
+	<-running
+	for _, name := range []string{"pending-1", "pending-2", "pending-3"} {
+		register(name, quick)
+	}
+	runtime.GC()
+	runtime.GC()
+
+	mu.Lock()
+	name := resurrected.Name
+	mu.Unlock()
+	log.Printf("ran: %v, resurrected: %s", ranNames(), name) // sim:gofinalizer stops here (finalizers pending)
+
+	close(release)
+	waitFor(6)
+	log.Printf("ran: %v, live: %s", ranNames(), live.Name) // sim:gofinalizer stops here
+	runtime.KeepAlive(live)
+}
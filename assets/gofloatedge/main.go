@@ -0,0 +1,59 @@
+// A program with float32 and float64 locals holding the IEEE 754 edge cases:
+// quiet and signaling NaNs (including a negative NaN and one with a payload),
+// positive and negative infinity, subnormals, negative zero, and the largest
+// and smallest normal values, plus arrays and structs containing them
+package main
+
+import (
+	"log"
+	"math"
+)
+
+type Sample struct {
+	Label string
+	F32   float32
+	F64   float64
+	C128  complex128
+}
+
+func main() {
+	// float64
+	quietNaN := math.NaN()
+	signalingNaN := math.Float64frombits(0x7ff0000000000001)
+	negativeNaN := math.Float64frombits(0xfff8000000000000)
+	payloadNaN := math.Float64frombits(0x7ff8000000c0ffee)
+	posInf := math.Inf(1)
+	negInf := math.Inf(-1)
+	negZero := math.Copysign(0, -1)
+	posZero := 0.0
+	smallestSubnormal := math.SmallestNonzeroFloat64
+	largestSubnormal := math.Float64frombits(0x000fffffffffffff)
+	smallestNormal := math.Float64frombits(0x0010000000000000)
+	maxFloat := math.MaxFloat64
+	epsilon := math.Nextafter(1, 2) - 1
+
+	// float32
+	quietNaN32 := float32(math.NaN())
+	signalingNaN32 := math.Float32frombits(0x7f800001)
+	posInf32 := float32(math.Inf(1))
+	negInf32 := float32(math.Inf(-1))
+	negZero32 := math.Float32frombits(0x80000000)
+	smallestSubnormal32 := float32(math.SmallestNonzeroFloat32)
+	largestSubnormal32 := math.Float32frombits(0x007fffff)
+	maxFloat32 := float32(math.MaxFloat32)
+
+	arr64 := [...]float64{quietNaN, signalingNaN, posInf, negInf, negZero, posZero, smallestSubnormal, maxFloat}
+	arr32 := [...]float32{quietNaN32, signalingNaN32, posInf32, negInf32, negZero32, smallestSubnormal32, largestSubnormal32, maxFloat32}
+	samples := []Sample{
+		{Label: "nan", F32: quietNaN32, F64: quietNaN, C128: complex(quietNaN, 1)},
+		{Label: "inf", F32: posInf32, F64: negInf, C128: complex(posInf, negInf)},
+		{Label: "negzero", F32: negZero32, F64: negZero, C128: complex(negZero, negZero)},
+		{Label: "subnormal", F32: smallestSubnormal32, F64: largestSubnormal, C128: complex(smallestSubnormal, smallestNormal)},
+	}
+
+	log.Printf("float64: %v %v %v %v %v %v %v %v %v %v %v %v %v",
+		quietNaN, signalingNaN, negativeNaN, payloadNaN, posInf, negInf, negZero, posZero,
+		smallestSubnormal, largestSubnormal, smallestNormal, maxFloat, epsilon)
+	log.Printf("float32: %v", arr32)
+	log.Printf("arr64: %v, samples: %d", arr64, len(samples)) // sim:gofloatedge stops here
+}
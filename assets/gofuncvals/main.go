@@ -0,0 +1,86 @@
+// A program that stores function values in locals, struct fields, maps, and
+// slices, and passes them to higher-order functions
+package main
+
+import (
+	"log"
+	"strings"
+)
+
+type Op func(a, b int) int
+
+type Handler struct {
+	Name     string
+	OnEvent  func(string) string
+	Fallback func(string) string
+}
+
+//go:noinline
+func add(a, b int) int {
+	return a + b
+}
+
+//go:noinline
+func mul(a, b int) int {
+	return a * b
+}
+
+//go:noinline
+func apply(op Op, a, b int) int {
+	res := op(a, b)
+	return res // sim:gofuncvals stops here
+}
+
+//go:noinline
+func compose(f, g func(int) int) func(int) int {
+	return func(x int) int {
+		return f(g(x))
+	}
+}
+
+func makeAdder(n int) func(int) int {
+	return func(x int) int {
+		return x + n
+	}
+}
+
+func main() {
+	// plain function values
+	addFn := Op(add)
+	mulFn := mul
+	var nilFn Op
+
+	// closures capturing state
+	offset := 7
+	closure := func(a, b int) int { return a - b + offset }
+	addTen := makeAdder(10)
+	double := func(x int) int { return x * 2 }
+	composed := compose(addTen, double)
+
+	// function values in aggregates
+	ops := map[string]Op{
+		"add": add,
+		"mul": mul,
+		"sub": closure,
+	}
+	pipeline := []func(int) int{addTen, double, composed}
+	handler := Handler{
+		Name:    "upper",
+		OnEvent: strings.ToUpper,
+	}
+
+	log.Printf("apply add: %d", apply(addFn, 2, 3))
+	log.Printf("apply mul: %d", apply(mulFn, 2, 3))
+	log.Printf("apply closure: %d", apply(closure, 2, 3))
+	for name, op := range ops {
+		log.Printf("ops[%s]: %d", name, apply(op, 4, 5))
+	}
+
+	x := 1
+	for _, f := range pipeline {
+		x = f(x)
+	}
+	log.Printf("pipeline: %d", x)
+	log.Printf("handler: %s", handler.OnEvent("hello"))
+	log.Printf("nil: %v, fallback: %v", nilFn == nil, handler.Fallback == nil)
+}
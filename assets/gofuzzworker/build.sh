@@ -0,0 +1,13 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): this asset is a fuzz test binary compiled with coverage instrumentation.
+# The coordinator re-executes itself to start its workers, so run it with i.e.
+#
+#   ./out -test.run '^$' -test.fuzz FuzzDecode -test.fuzzcachedir fuzzcache -test.parallel 4
+#
+# and attach to one of the child processes. Crashing inputs are written to testdata/fuzz/.
+#
+
+set -x
+go test -c -fuzz=FuzzDecode -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
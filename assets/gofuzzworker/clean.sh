@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+set -x
+rm -rf out out_* fuzzcache testdata
@@ -0,0 +1,3 @@
+module gofuzzworker
+
+go 1.23
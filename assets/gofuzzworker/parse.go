@@ -0,0 +1,42 @@
+// Package parse decodes a small length-prefixed record format. Its fuzz test
+// is compiled into a standalone binary that acts as both the fuzzing
+// coordinator and (when re-executed by the coordinator) its worker processes.
+package parse
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+type Record struct {
+	Kind    byte
+	Payload []byte
+}
+
+var errShort = errors.New("short record")
+
+//go:noinline
+func Decode(data []byte) ([]Record, error) {
+	var records []Record
+	for len(data) > 0 {
+		if len(data) < 3 {
+			return nil, errShort
+		}
+		kind := data[0]
+		n := int(binary.LittleEndian.Uint16(data[1:3]))
+		data = data[3:]
+		if n > len(data) {
+			return nil, errShort
+		}
+
+		// a deliberate bug for the fuzzer to find: a kind 0xff record whose
+		// payload starts with '!' reads past the end of its payload
+		if kind == 0xff && n >= 1 && data[0] == '!' {
+			_ = data[n+1] // sim:gofuzzworker panics here (in a fuzz worker)
+		}
+
+		records = append(records, Record{Kind: kind, Payload: data[:n]}) // sim:gofuzzworker stops here (Decode)
+		data = data[n:]
+	}
+	return records, nil
+}
@@ -0,0 +1,21 @@
+package parse
+
+import "testing"
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte{0x01, 0x02, 0x00, 'h', 'i'})
+	f.Add([]byte{0xff, 0x03, 0x00, 'b', 'o', 'o'})
+	f.Add([]byte{0x02, 0x00, 0x00})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		records, err := Decode(data) // sim:gofuzzworker stops here (fuzz target)
+		if err != nil {
+			return
+		}
+		for _, r := range records {
+			if len(r.Payload) > len(data) {
+				t.Fatalf("payload longer than input: %d > %d", len(r.Payload), len(data))
+			}
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// A program that allocates aggressively from several goroutines, registers
+// finalizers on some of those allocations, and forces collections so the
+// process can be stopped mid-GC with GC worker goroutines running
+package main
+
+import (
+	"log"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Node struct {
+	ID       int
+	Payload  []byte
+	Next     *Node
+	Children []*Node
+}
+
+type Tracked struct {
+	Name string
+	Buf  []byte
+}
+
+var finalized atomic.Int64
+
+//go:noinline
+func onFinalize(t *Tracked) {
+	n := finalized.Add(1)
+	_ = n // sim:gogcstress stops here (in the finalizer goroutine)
+}
+
+//go:noinline
+func buildTree(id, depth int) *Node {
+	n := &Node{ID: id, Payload: make([]byte, 256)}
+	if depth == 0 {
+		return n
+	}
+	for ndx := range 4 {
+		n.Children = append(n.Children, buildTree(id*4+ndx, depth-1))
+	}
+	return n
+}
+
+//go:noinline
+func churn(worker int, iterations int, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	var keep *Node
+	for ndx := range iterations {
+		tree := buildTree(ndx, 4)
+
+		// keep a short linked list alive so some objects survive collections
+		tree.Next = keep
+		keep = tree
+		if ndx%8 == 0 {
+			keep = nil
+		}
+
+		if ndx%16 == 0 {
+			t := &Tracked{Name: "tracked", Buf: make([]byte, 4096)}
+			runtime.SetFinalizer(t, onFinalize)
+		}
+	}
+	runtime.KeepAlive(keep)
+}
+
+func main() {
+	// collect often to maximize the amount of time spent in the GC
+	debug.SetGCPercent(10)
+
+	const workers = 4
+	const rounds = 5
+	for round := range rounds {
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for worker := range workers {
+			go churn(worker, 200, &wg)
+		}
+
+		// force a collection while the workers are still allocating
+		runtime.GC() // sim:gogcstress stops here (during a collection)
+		wg.Wait()
+
+		runtime.GC()
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		log.Printf("round %d: num gc: %d, heap alloc: %d, finalized: %d", round, stats.NumGC, stats.HeapAlloc, finalized.Load()) // sim:gogcstress stops here (after a collection)
+	}
+
+	// give the finalizer goroutine a chance to run the remaining finalizers
+	runtime.GC()
+	time.Sleep(100 * time.Millisecond)
+	log.Printf("done, finalized: %d", finalized.Load())
+}
@@ -0,0 +1,53 @@
+// Package config holds exported and unexported package-level variables that
+// are initialized both statically and at init time
+package config
+
+import "time"
+
+type Settings struct {
+	Name     string
+	Port     int
+	Timeout  time.Duration
+	Features map[string]bool
+}
+
+// exported globals
+var (
+	Version   = "1.2.3"
+	BuildNum  = 4567
+	Debug     bool
+	Defaults  = Settings{Name: "default", Port: 8080, Timeout: 5 * time.Second}
+	Overrides *Settings
+)
+
+// unexported globals
+var (
+	secret    = "hunter2"
+	loadCount int
+	envNames  = []string{"DEV", "STAGING", "PROD"}
+)
+
+const MaxRetries = 3
+
+func init() {
+	Defaults.Features = map[string]bool{
+		"fast-path": true,
+		"telemetry": false,
+	}
+}
+
+func Load() *Settings {
+	loadCount++
+	if Overrides == nil {
+		Overrides = &Settings{
+			Name:    "override-" + envNames[loadCount%len(envNames)],
+			Port:    Defaults.Port + loadCount,
+			Timeout: Defaults.Timeout * 2,
+		}
+	}
+	return Overrides
+}
+
+func Secret() string {
+	return secret
+}
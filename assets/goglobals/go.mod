@@ -0,0 +1,3 @@
+module goglobals
+
+go 1.23
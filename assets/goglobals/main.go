@@ -0,0 +1,39 @@
+// A program with package-level variables of many kinds spread across
+// several packages
+package main
+
+import (
+	"log"
+
+	"goglobals/config"
+	"goglobals/registry"
+)
+
+var (
+	mainCounter int
+	mainName    = "goglobals"
+	mainPtr     *int
+	mainIface   any = registry.Entry{Key: "boxed", Value: 1}
+	mainFunc        = config.Load
+)
+
+func main() {
+	mainPtr = &mainCounter
+	for ndx := range registry.Table {
+		registry.Table[ndx] = int64(ndx * ndx)
+	}
+
+	reg := registry.Get()
+	reg.Put("first", 1)
+	reg.Put("second", 2)
+	mainCounter = registry.Len()
+
+	settings := mainFunc()
+	config.Debug = true
+
+	log.Printf("%s: version %s, build %d", mainName, config.Version, config.BuildNum) // sim:goglobals stops here
+	log.Printf("settings: %+v, defaults: %+v", *settings, config.Defaults)
+	log.Printf("secret: %s, max retries: %d", config.Secret(), config.MaxRetries)
+	log.Printf("primes: %v, codes: %v", registry.Primes, registry.Codes)
+	log.Printf("counter: %d, iface: %v", *mainPtr, mainIface)
+}
@@ -0,0 +1,58 @@
+// Package registry holds large and lazily-initialized package-level variables
+package registry
+
+import "sync"
+
+type Entry struct {
+	Key   string
+	Value int
+}
+
+var (
+	// a large array that lives in .bss until it's written to
+	Table [4096]int64
+
+	// a small array that lives in .data because it's statically initialized
+	Primes = [16]int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53}
+
+	// an initialized map
+	Codes = map[string]int{
+		"ok":        200,
+		"not-found": 404,
+		"teapot":    418,
+		"error":     500,
+	}
+
+	entries []Entry
+)
+
+var (
+	once     sync.Once
+	instance *Registry
+)
+
+type Registry struct {
+	mu    sync.Mutex
+	items map[string]Entry
+}
+
+// Get returns the singleton, creating it the first time it's called
+func Get() *Registry {
+	once.Do(func() {
+		instance = &Registry{items: make(map[string]Entry)}
+	})
+	return instance
+}
+
+func (r *Registry) Put(key string, val int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e := Entry{Key: key, Value: val}
+	r.items[key] = e
+	entries = append(entries, e)
+}
+
+func Len() int {
+	return len(entries)
+}
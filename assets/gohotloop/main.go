@@ -0,0 +1,38 @@
+// A program with a multi-million iteration tight loop containing a few simple
+// statements, for benchmarking step-over throughput and conditional breakpoint
+// overhead. The number of iterations may be passed as an argument.
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const defaultIterations = 10_000_000
+
+func main() {
+	iterations := defaultIterations
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid number of iterations %q: %v", os.Args[1], err)
+		}
+		iterations = n
+	}
+
+	start := time.Now()
+	sum := 0
+	evens := 0
+	last := 0
+	for ndx := 0; ndx < iterations; ndx++ {
+		sum += ndx // sim:gohotloop stops here
+		if ndx%2 == 0 {
+			evens++
+		}
+		last = ndx
+	}
+
+	log.Printf("%d iterations in %s: sum=%d, evens=%d, last=%d", iterations, time.Since(start), sum, evens, last)
+}
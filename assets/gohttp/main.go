@@ -0,0 +1,113 @@
+// A long-running HTTP server with a background client that continuously
+// drives requests to it. Intended for attaching to a running server and
+// breaking inside a handler. The listen address may be passed as an argument
+// (default: 127.0.0.1:8421).
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Item struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Price int    `json:"price"`
+}
+
+type Server struct {
+	requests atomic.Int64
+
+	mu    sync.Mutex
+	items map[int64]Item
+}
+
+func (s *Server) handleHello(w http.ResponseWriter, r *http.Request) {
+	n := s.requests.Add(1)
+	name := r.URL.Query().Get("name")
+	userAgent := r.Header.Get("User-Agent")
+
+	msg := fmt.Sprintf("hello %s (request %d, agent %s)\n", name, n, userAgent) // sim:gohttp stops here (GET)
+	_, _ = io.WriteString(w, msg)
+}
+
+func (s *Server) handleItems(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var item Item
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	item.Price *= 2
+	s.mu.Lock()
+	s.items[item.ID] = item
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(item) // sim:gohttp stops here (POST)
+}
+
+func client(addr string) {
+	c := &http.Client{Timeout: 5 * time.Second}
+	for ndx := int64(0); ; ndx++ {
+		time.Sleep(time.Second)
+
+		resp, err := c.Get(fmt.Sprintf("http://%s/hello?name=client-%d", addr, ndx))
+		if err != nil {
+			log.Printf("GET failed: %v", err)
+			continue
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		log.Printf("GET: %s", strings.TrimSpace(string(body)))
+
+		payload := fmt.Sprintf(`{"id": %d, "name": "widget-%d", "price": %d}`, ndx, ndx, ndx*100)
+		resp, err = c.Post(fmt.Sprintf("http://%s/items", addr), "application/json", strings.NewReader(payload))
+		if err != nil {
+			log.Printf("POST failed: %v", err)
+			continue
+		}
+		body, _ = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		log.Printf("POST: %s", strings.TrimSpace(string(body)))
+	}
+}
+
+func main() {
+	addr := "127.0.0.1:8421"
+	if len(os.Args) > 1 {
+		addr = os.Args[1]
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", addr, err)
+	}
+	log.Printf("gohttp (pid: %d) listening on %s", os.Getpid(), ln.Addr())
+
+	s := &Server{items: make(map[int64]Item)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello", s.handleHello)
+	mux.HandleFunc("/items", s.handleItems)
+
+	go client(ln.Addr().String())
+
+	if err := http.Serve(ln, mux); err != nil {
+		log.Fatalf("server failed: %v", err)
+	}
+}
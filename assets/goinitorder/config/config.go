@@ -0,0 +1,31 @@
+// Package config is initialized after trace, which it imports, and before
+// plugins and main, which import it
+package config
+
+import "goinitorder/trace"
+
+// package-level variables are initialized in dependency order before any
+// of the package's init functions run
+var (
+	Defaults = loadDefaults()
+	Port     = Defaults["port"]
+	order    = trace.Record("config.vars")
+)
+
+//go:noinline
+func loadDefaults() map[string]string {
+	trace.Record("config.loadDefaults")
+	return map[string]string{"port": "8080", "env": "dev"}
+}
+
+func init() {
+	trace.Record("config.init #1") // sim:goinitorder stops here (config init)
+	Defaults["init1"] = "done"
+}
+
+// a package may have any number of init functions, which run in the order
+// they appear in the source
+func init() {
+	trace.Record("config.init #2")
+	Defaults["init2"] = "done"
+}
@@ -0,0 +1,3 @@
+module goinitorder
+
+go 1.23
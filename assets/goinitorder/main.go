@@ -0,0 +1,25 @@
+// A program with several packages whose package-level variables and init
+// functions have observable side effects, recorded in the order they run.
+// Everything up to main.main runs from runtime.main via the package inits.
+package main
+
+import (
+	"log"
+	"strings"
+
+	"goinitorder/config"
+	_ "goinitorder/plugins"
+	"goinitorder/trace"
+)
+
+var banner = trace.Record("main.vars")
+
+func init() {
+	trace.Record("main.init") // sim:goinitorder stops here (main init)
+}
+
+func main() {
+	trace.Record("main.main")
+	events := trace.Events()
+	log.Printf("banner: %d, env: %s, init order:\n\t%s", banner, config.Defaults["env"], strings.Join(events, "\n\t")) // sim:goinitorder stops here
+}
@@ -0,0 +1,26 @@
+// Package plugins registers built-in plugins from its init function, the
+// same way database/sql drivers and image decoders register themselves
+package plugins
+
+import (
+	"goinitorder/config"
+	"goinitorder/trace"
+)
+
+type Plugin struct {
+	Name string
+	Port string
+}
+
+var Registry = map[string]Plugin{}
+
+//go:noinline
+func Register(p Plugin) {
+	Registry[p.Name] = p
+	trace.Record("plugins.Register " + p.Name)
+}
+
+func init() {
+	Register(Plugin{Name: "http", Port: config.Port}) // sim:goinitorder stops here (plugins init)
+	Register(Plugin{Name: "metrics", Port: "9090"})
+}
@@ -0,0 +1,29 @@
+// Package trace records the order in which package initializers run. It
+// doesn't import any other package in this module, so it's initialized
+// before every other package in this module.
+package trace
+
+import "sync"
+
+var (
+	mu     sync.Mutex
+	events []string
+)
+
+//go:noinline
+func Record(event string) int {
+	mu.Lock()
+	defer mu.Unlock()
+	events = append(events, event) // sim:goinitorder stops here (Record)
+	return len(events)
+}
+
+func Events() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]string(nil), events...)
+}
+
+func init() {
+	Record("trace.init")
+}
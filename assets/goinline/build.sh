@@ -0,0 +1,9 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): unlike the other Go assets, this is always built with the default
+# optimizations (no -N -l) so the compiler actually inlines the leaf functions
+#
+
+set -x
+go build -gcflags="${GCFLAGS:-all=}" -o ${OUT:-out} main.go
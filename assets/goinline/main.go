@@ -0,0 +1,72 @@
+// A program with small leaf functions that the compiler inlines, alongside
+// identical copies marked //go:noinline for contrast. This asset is built
+// with the default optimization settings so inlining actually happens.
+//
+// To see the compiler's inlining decisions:
+//
+//	$ go build -gcflags=-m -o out main.go
+package main
+
+import "log"
+
+type Vec struct {
+	X, Y float64
+}
+
+func add(a, b int) int {
+	return a + b // sim:goinline stops here (inlined)
+}
+
+//go:noinline
+func addNoInline(a, b int) int {
+	return a + b // sim:goinline stops here (not inlined)
+}
+
+func (v Vec) Scale(f float64) Vec {
+	return Vec{X: v.X * f, Y: v.Y * f}
+}
+
+//go:noinline
+func (v Vec) ScaleNoInline(f float64) Vec {
+	return Vec{X: v.X * f, Y: v.Y * f}
+}
+
+// clamp calls other inlinable functions, so it produces nested inlined frames
+func clamp(v, lo, hi int) int {
+	return minInt(maxInt(v, lo), hi)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b // sim:goinline stops here (nested inline: main -> clamp -> maxInt)
+}
+
+//go:noinline
+func clampNoInline(v, lo, hi int) int {
+	return minInt(maxInt(v, lo), hi)
+}
+
+func main() {
+	a := add(1, 2)
+	b := addNoInline(3, 4)
+	log.Printf("add: %d, addNoInline: %d", a, b)
+
+	v := Vec{X: 1.5, Y: -2}
+	s1 := v.Scale(2)
+	s2 := v.ScaleNoInline(3)
+	log.Printf("Scale: %v, ScaleNoInline: %v", s1, s2)
+
+	c1 := clamp(15, 0, 10)
+	c2 := clamp(-5, 0, 10)
+	c3 := clampNoInline(5, 0, 10)
+	log.Printf("clamp: %d, %d, clampNoInline: %d", c1, c2, c3)
+}
@@ -0,0 +1,98 @@
+// A program that uses range-over-function iterators (iter.Seq and iter.Seq2)
+// including the standard library's iterator helpers, nested iterators, and
+// early exit from a range loop
+package main
+
+import (
+	"iter"
+	"log"
+	"maps"
+	"slices"
+)
+
+type Tree struct {
+	Left, Right *Tree
+	Value       int
+}
+
+// All walks the tree in order
+func (t *Tree) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		t.walk(yield)
+	}
+}
+
+func (t *Tree) walk(yield func(int) bool) bool {
+	if t == nil {
+		return true
+	}
+	return t.Left.walk(yield) && yield(t.Value) && t.Right.walk(yield)
+}
+
+// Fib yields fibonacci numbers forever
+func Fib() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 0, 1
+		for {
+			if !yield(a) { // sim:goiter stops here (inside the iterator)
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+// Enumerate pairs each element of seq with its index
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		ndx := 0
+		for v := range seq {
+			if !yield(ndx, v) {
+				return
+			}
+			ndx++
+		}
+	}
+}
+
+func main() {
+	tree := &Tree{
+		Value: 4,
+		Left:  &Tree{Value: 2, Left: &Tree{Value: 1}, Right: &Tree{Value: 3}},
+		Right: &Tree{Value: 6, Left: &Tree{Value: 5}, Right: &Tree{Value: 7}},
+	}
+
+	sum := 0
+	for v := range tree.All() {
+		sum += v // sim:goiter stops here (loop body is the yield callback)
+	}
+	log.Printf("tree sum: %d", sum)
+
+	// break out of an infinite iterator early
+	var fibs []int
+	for ndx, v := range Enumerate(Fib()) {
+		if ndx >= 10 {
+			break
+		}
+		fibs = append(fibs, v)
+	}
+	log.Printf("fibs: %v", fibs)
+
+	// standard library iterators
+	ages := map[string]int{"alice": 30, "bob": 25, "carol": 35}
+	names := slices.Sorted(maps.Keys(ages))
+	for ndx, name := range slices.All(names) {
+		log.Printf("%d: %s is %d", ndx, name, ages[name])
+	}
+
+	// return from inside a range-over-func loop
+	found := func() int {
+		for v := range tree.All() {
+			if v > 4 {
+				return v
+			}
+		}
+		return -1
+	}()
+	log.Printf("first > 4: %d", found)
+}
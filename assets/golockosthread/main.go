@@ -0,0 +1,81 @@
+// A program with goroutines pinned to OS threads via runtime.LockOSThread,
+// running alongside ordinary goroutines that the scheduler is free to move
+package main
+
+import (
+	"log"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+)
+
+//go:noinline
+func lockedWork(name string, rounds int) int {
+	tid := syscall.Gettid()
+	sum := 0
+	for ndx := range rounds {
+		sum += ndx
+		if ndx == rounds/2 {
+			log.Printf("%s running on locked thread %d", name, tid) // sim:golockosthread stops here (locked)
+		}
+	}
+
+	if now := syscall.Gettid(); now != tid {
+		log.Fatalf("%s moved from thread %d to %d while locked", name, tid, now)
+	}
+	return sum
+}
+
+//go:noinline
+func unlockedWork(name string, rounds int) {
+	tids := map[int]bool{}
+	for range rounds {
+		tids[syscall.Gettid()] = true
+		runtime.Gosched()
+	}
+	log.Printf("%s ran on %d distinct threads", name, len(tids)) // sim:golockosthread stops here (unlocked)
+}
+
+func main() {
+	// the main goroutine is locked to the main thread for the duration of main
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	log.Printf("main locked to thread %d (pid: %d)", syscall.Gettid(), syscall.Getpid())
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"locked-a", "locked-b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+
+			lockedWork(name, 50_000_000)
+		}()
+	}
+
+	// a goroutine that exits without unlocking, which causes the runtime to
+	// terminate its thread rather than return it to the pool
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		runtime.LockOSThread()
+		log.Printf("locked-exit will terminate thread %d", syscall.Gettid())
+		time.Sleep(500 * time.Millisecond)
+	}()
+
+	for _, name := range []string{"unlocked-a", "unlocked-b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlockedWork(name, 10_000)
+		}()
+	}
+
+	wg.Wait()
+	lockedWork("main", 1000)
+	log.Printf("golockosthread done")
+}
@@ -0,0 +1,61 @@
+// A program with absurdly long identifiers and deeply nested generic
+// instantiations, whose DWARF and symbol names run to many hundreds of
+// characters
+package main
+
+import "log"
+
+type ThisIsAnExtremelyLongStructNameThatKeepsGoingAndGoingWellPastAnyReasonableLengthForAnIdentifierInARealProgram struct {
+	ThisIsAnEquallyLongFieldNameThatIsDesignedToOverflowAnyFixedWidthColumnInTheVariablesPane int
+	AndAnotherFieldWithAVeryLongNameJustToMakeSureMultipleLongMembersRenderCorrectlyTogether  string
+}
+
+type Box[T any] struct {
+	Value T
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+type Wrapper[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+type Deep = Box[Pair[string, Wrapper[Box[Box[Box[int]]], Pair[int, Box[map[string][]Box[Pair[uint8, float64]]]], ThisIsAnExtremelyLongStructNameThatKeepsGoingAndGoingWellPastAnyReasonableLengthForAnIdentifierInARealProgram]]]
+
+//go:noinline
+func thisIsAFunctionWithAnAbsurdlyLongNameThatDescribesEverythingItDoesInExcruciatingDetailIncludingTheFactThatItReturnsAnInteger(thisIsAParameterWithAnEquallyAbsurdlyLongNameThatNobodyWouldEverActuallyWrite int) int {
+	thisIsALocalVariableWhoseNameIsAlsoWayTooLongForComfortButItIsImportantToTestTheVariablePaneLayout := thisIsAParameterWithAnEquallyAbsurdlyLongNameThatNobodyWouldEverActuallyWrite * 2
+	return thisIsALocalVariableWhoseNameIsAlsoWayTooLongForComfortButItIsImportantToTestTheVariablePaneLayout // sim:golongnames stops here (long function name)
+}
+
+//go:noinline
+func nestedGeneric[T any](v T) Box[Box[Box[Box[Box[T]]]]] {
+	result := Box[Box[Box[Box[Box[T]]]]]{Value: Box[Box[Box[Box[T]]]]{Value: Box[Box[Box[T]]]{Value: Box[Box[T]]{Value: Box[T]{Value: v}}}}}
+	return result // sim:golongnames stops here (nested generic instantiation)
+}
+
+func main() {
+	long := ThisIsAnExtremelyLongStructNameThatKeepsGoingAndGoingWellPastAnyReasonableLengthForAnIdentifierInARealProgram{
+		ThisIsAnEquallyLongFieldNameThatIsDesignedToOverflowAnyFixedWidthColumnInTheVariablesPane: 1,
+		AndAnotherFieldWithAVeryLongNameJustToMakeSureMultipleLongMembersRenderCorrectlyTogether:  "long",
+	}
+
+	var deep Deep
+	deep.Value.Key = "deep"
+	deep.Value.Value.First.Value.Value.Value = 42
+	deep.Value.Value.Second = Pair[int, Box[map[string][]Box[Pair[uint8, float64]]]]{
+		Key:   7,
+		Value: Box[map[string][]Box[Pair[uint8, float64]]]{Value: map[string][]Box[Pair[uint8, float64]]{"k": {{Value: Pair[uint8, float64]{Key: 1, Value: 2.5}}}}},
+	}
+	deep.Value.Value.Third = long
+
+	nested := nestedGeneric(deep)
+	n := thisIsAFunctionWithAnAbsurdlyLongNameThatDescribesEverythingItDoesInExcruciatingDetailIncludingTheFactThatItReturnsAnInteger(21)
+
+	log.Printf("n: %d, nested key: %s, long: %+v", n, nested.Value.Value.Value.Value.Value.Value.Key, long) // sim:golongnames stops here
+}
@@ -0,0 +1,83 @@
+// A program that runs forever with several worker goroutines doing CPU work,
+// contending on a shared lock, and periodically allocating. Intended as a
+// realistic target for attaching, detaching, re-attaching, and pausing.
+// The number of workers may be passed as an argument (default: 4).
+package main
+
+import (
+	"crypto/sha256"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type Stats struct {
+	mu      sync.Mutex
+	hashes  map[int]uint64
+	allocs  uint64
+	retired [][]byte
+}
+
+//go:noinline
+func hashWork(seed int, rounds int) [32]byte {
+	sum := sha256.Sum256([]byte(strconv.Itoa(seed)))
+	for range rounds {
+		sum = sha256.Sum256(sum[:])
+	}
+	return sum
+}
+
+func worker(id int, stats *Stats) {
+	for iter := 0; ; iter++ {
+		sum := hashWork(id*1_000_000+iter, 10_000)
+
+		stats.mu.Lock()
+		stats.hashes[id]++ // sim:golongrun stops here (holding the shared lock)
+		if iter%100 == 0 {
+			// periodically allocate something that outlives the iteration
+			buf := make([]byte, 64*1024)
+			copy(buf, sum[:])
+			stats.retired = append(stats.retired, buf)
+			stats.allocs++
+
+			// bound memory usage so the program can run for hours
+			if len(stats.retired) > 256 {
+				stats.retired = stats.retired[128:]
+			}
+		}
+		stats.mu.Unlock()
+	}
+}
+
+func main() {
+	workers := 4
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil || n <= 0 {
+			log.Fatalf("invalid number of workers: %q", os.Args[1])
+		}
+		workers = n
+	}
+
+	log.Printf("golongrun (pid: %d) starting %d workers", os.Getpid(), workers)
+
+	stats := &Stats{hashes: make(map[int]uint64)}
+	for id := range workers {
+		go worker(id, stats)
+	}
+
+	start := time.Now()
+	for range time.Tick(5 * time.Second) {
+		stats.mu.Lock()
+		total := uint64(0)
+		for _, n := range stats.hashes {
+			total += n
+		}
+		allocs := stats.allocs
+		stats.mu.Unlock()
+
+		log.Printf("uptime: %s, iterations: %d, allocs: %d", time.Since(start).Round(time.Second), total, allocs)
+	}
+}
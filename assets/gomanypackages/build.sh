@@ -0,0 +1,5 @@
+#!/usr/bin/env bash
+
+set -x
+go run generate.go -packages ${PACKAGES:-300}
+go build -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
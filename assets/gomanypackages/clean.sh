@@ -0,0 +1,6 @@
+#!/usr/bin/env bash
+
+set -x
+rm -f out out_*
+rm -rf pkgs
+rm -f zz_generated.go
@@ -0,0 +1,100 @@
+//go:build ignore
+
+// Generates the packages that make up the gomanypackages asset. Packages are
+// grouped in to nested directories, and every package has files with the same
+// set of names (handlers.go, models.go, util.go, ...) to make file search
+// ranking non-trivial.
+//
+//	$ go run generate.go -packages 300
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+const (
+	module        = "gomanypackages"
+	outDir        = "pkgs"
+	groupSize     = 10
+	funcsPerFile  = 3
+	generatedMain = "zz_generated.go"
+)
+
+var files = []string{"handlers", "models", "util", "service", "errors"}
+
+func main() {
+	numPackages := flag.Int("packages", 300, "the number of packages to generate")
+	flag.Parse()
+
+	if err := os.RemoveAll(outDir); err != nil {
+		log.Fatalf("failed to remove %s: %v", outDir, err)
+	}
+
+	var imports, calls bytes.Buffer
+	for ndx := range *numPackages {
+		group := fmt.Sprintf("group%02d", ndx/groupSize)
+		pkg := fmt.Sprintf("pkg%03d", ndx)
+		dir := filepath.Join(outDir, group, pkg)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			log.Fatalf("failed to create %s: %v", dir, err)
+		}
+
+		for fileNdx, name := range files {
+			writePackageFile(filepath.Join(dir, name+".go"), pkg, ndx, fileNdx, name)
+		}
+
+		fmt.Fprintf(&imports, "\t%q\n", module+"/"+outDir+"/"+group+"/"+pkg)
+		fmt.Fprintf(&calls, "\ttotal += %s.Entry(%d)\n", pkg, ndx)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by generate.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package main\n\nimport (\n%s)\n\n", imports.String())
+	fmt.Fprintf(&buf, "func runAll() int {\n\ttotal := 0\n%s\treturn total\n}\n", calls.String())
+	writeFormatted(generatedMain, buf.Bytes())
+
+	log.Printf("generated %d packages with %d files each", *numPackages, len(files))
+}
+
+func writePackageFile(path, pkg string, pkgNdx, fileNdx int, name string) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by generate.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+
+	// the first file contains the package's entrypoint, which calls a function in every file
+	if fileNdx == 0 {
+		fmt.Fprintf(&buf, "//go:noinline\nfunc Entry(n int) int {\n\tres := n\n")
+		for _, other := range files {
+			fmt.Fprintf(&buf, "\tres += %s0(res)\n", other)
+		}
+		fmt.Fprintf(&buf, "\treturn res\n}\n\n")
+	}
+
+	for fn := range funcsPerFile {
+		fmt.Fprintf(&buf, "//go:noinline\nfunc %s%d(n int) int {\n", name, fn)
+		fmt.Fprintf(&buf, "\t%sLocal := n*%d + %d\n", name, pkgNdx+1, fn)
+		if fn+1 < funcsPerFile {
+			fmt.Fprintf(&buf, "\treturn %s%d(%sLocal) %% 1000\n}\n\n", name, fn+1, name)
+		} else {
+			fmt.Fprintf(&buf, "\treturn %sLocal %% 1000\n}\n\n", name)
+		}
+	}
+
+	writeFormatted(path, buf.Bytes())
+}
+
+func writeFormatted(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("failed to format %s: %v\n%s", path, err, src)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatalf("failed to write %s: %v", path, err)
+	}
+}
@@ -0,0 +1,3 @@
+module gomanypackages
+
+go 1.23
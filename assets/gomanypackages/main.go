@@ -0,0 +1,11 @@
+// A program spread across hundreds of small generated packages, each with
+// several files containing breakable functions. The packages are generated by
+// build.sh (see generate.go) rather than checked in.
+package main
+
+import "log"
+
+func main() {
+	total := runAll()
+	log.Printf("total: %d", total) // sim:gomanypackages stops here
+}
@@ -0,0 +1,59 @@
+// A program that calls methods through value receivers, pointer receivers,
+// method values, and method expressions on both stack and heap objects
+package main
+
+import "log"
+
+type Counter struct {
+	Name  string
+	Count int
+}
+
+//go:noinline
+func (c Counter) Value() int {
+	total := c.Count * 10
+	return total // sim:gomethods stops here (value receiver)
+}
+
+//go:noinline
+func (c *Counter) Increment(by int) {
+	c.Count += by // sim:gomethods stops here (pointer receiver)
+}
+
+//go:noinline
+func (c *Counter) Reset() {
+	c.Count = 0
+}
+
+var heapCounters []*Counter
+
+func main() {
+	// this counter does not escape, so it should live on the stack
+	stack := Counter{Name: "stack", Count: 1}
+	stack.Increment(2)
+	log.Printf("stack value: %d", stack.Value())
+
+	// this counter escapes to the heap
+	heap := &Counter{Name: "heap", Count: 100}
+	heapCounters = append(heapCounters, heap)
+	heap.Increment(5)
+	log.Printf("heap value: %d", heap.Value())
+
+	// method values bind the receiver at the time they're created
+	valueFn := stack.Value
+	incrFn := heap.Increment
+	stack.Count = 50
+	incrFn(1)
+	log.Printf("method value: %d, heap: %d", valueFn(), heap.Count)
+
+	// method expressions take the receiver as the first argument
+	valueExpr := Counter.Value
+	incrExpr := (*Counter).Increment
+	incrExpr(&stack, 3)
+	log.Printf("method expression: %d", valueExpr(stack))
+
+	// methods through an interface
+	var resetter interface{ Reset() } = heap
+	resetter.Reset()
+	log.Printf("after reset: %d", heap.Count)
+}
@@ -0,0 +1,94 @@
+// A program that maps memory outside of the Go heap with mmap: an anonymous
+// read/write region, a shared file-backed region that's written through to
+// a temp file, and a region that's later mprotected read-only (and then
+// inaccessible), and reads and writes data inside each of them
+package main
+
+import (
+	"encoding/binary"
+	"log"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+type Header struct {
+	Magic   [4]byte
+	Version uint32
+	Count   uint64
+}
+
+func main() {
+	pageSize := os.Getpagesize()
+
+	// anonymous private mapping
+	anon, err := syscall.Mmap(-1, 0, 4*pageSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		log.Fatalf("error mapping anonymous memory: %v", err)
+	}
+	defer syscall.Munmap(anon)
+	for ndx := range anon {
+		anon[ndx] = byte(ndx % 251)
+	}
+
+	// a struct overlaid on the start of the anonymous region
+	hdr := (*Header)(unsafe.Pointer(&anon[0]))
+	hdr.Magic = [4]byte{'M', 'M', 'A', 'P'}
+	hdr.Version = 3
+	hdr.Count = 42
+
+	// shared file-backed mapping
+	path := filepath.Join(os.TempDir(), "gommap.bin")
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		log.Fatalf("error creating %s: %v", path, err)
+	}
+	defer os.Remove(path)
+	defer f.Close()
+	if err := f.Truncate(int64(2 * pageSize)); err != nil {
+		log.Fatalf("error truncating %s: %v", path, err)
+	}
+
+	file, err := syscall.Mmap(int(f.Fd()), 0, 2*pageSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		log.Fatalf("error mapping %s: %v", path, err)
+	}
+	defer syscall.Munmap(file)
+	copy(file, "written through a shared mapping")
+	binary.LittleEndian.PutUint64(file[pageSize:], 0xdeadbeefcafef00d)
+
+	// a region whose protection changes over time
+	prot, err := syscall.Mmap(-1, 0, pageSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		log.Fatalf("error mapping protected memory: %v", err)
+	}
+	defer syscall.Munmap(prot)
+	copy(prot, "soon to be read-only")
+
+	log.Printf("anon: %p, file: %p, prot: %p (pid: %d)", &anon[0], &file[0], &prot[0], os.Getpid()) // sim:gommap stops here (all regions writable)
+
+	if err := syscall.Mprotect(prot, syscall.PROT_READ); err != nil {
+		log.Fatalf("error making region read-only: %v", err)
+	}
+	log.Printf("prot is read-only: %q", prot[:20]) // sim:gommap stops here (read-only)
+
+	// modify the other regions while the protected one can't be written
+	hdr.Count++
+	anon[pageSize] = 0xff
+	file[0] = 'W'
+
+	if err := syscall.Mprotect(prot, syscall.PROT_NONE); err != nil {
+		log.Fatalf("error making region inaccessible: %v", err)
+	}
+	log.Printf("prot is inaccessible, hdr: %+v", *hdr) // sim:gommap stops here (inaccessible)
+
+	if _, _, errno := syscall.Syscall(syscall.SYS_MSYNC, uintptr(unsafe.Pointer(&file[0])), uintptr(len(file)), syscall.MS_SYNC); errno != 0 {
+		log.Fatalf("error syncing %s: %v", path, errno)
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("error reading %s: %v", path, err)
+	}
+	log.Printf("file contents: %q", contents[:32])
+}
@@ -0,0 +1,63 @@
+// A program with multi-dimensional arrays, arrays of structs, and structs
+// whose fields are themselves arrays
+package main
+
+import "log"
+
+type Cell struct {
+	Row, Col int8
+	Value    float32
+}
+
+type Board struct {
+	Name   string
+	Cells  [3][3]Cell
+	Scores [2]int
+	Rows   [3][]int
+}
+
+func main() {
+	var grid [4][8]int
+	for row := range grid {
+		for col := range grid[row] {
+			grid[row][col] = row*10 + col
+		}
+	}
+
+	var cube [2][3][4]float64
+	for ndx := range cube {
+		for jdx := range cube[ndx] {
+			for kdx := range cube[ndx][jdx] {
+				cube[ndx][jdx][kdx] = float64(ndx*100+jdx*10+kdx) + 0.5
+			}
+		}
+	}
+
+	var cells [5]Cell
+	for ndx := range cells {
+		cells[ndx] = Cell{Row: int8(ndx), Col: int8(-ndx), Value: float32(ndx) * 1.5}
+	}
+
+	board := Board{Name: "tic-tac-toe", Scores: [2]int{3, 4}}
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			board.Cells[row][col] = Cell{Row: int8(row), Col: int8(col), Value: float32(row*3 + col)}
+		}
+		board.Rows[row] = make([]int, row+1)
+	}
+
+	boards := [2]Board{board, {Name: "empty"}}
+	slices := [][3]int{{1, 2, 3}, {4, 5, 6}}
+	ptrs := [2]*[4]int{&[4]int{1, 2, 3, 4}, nil}
+	empty := [0][4]int{}
+
+	sum := 0.0
+	for _, plane := range cube {
+		for _, row := range plane {
+			for _, v := range row {
+				sum += v
+			}
+		}
+	}
+	log.Printf("grid[3][7]: %d, cube sum: %.1f, boards: %d, slices: %d, ptrs: %d, empty: %d", grid[3][7], sum, len(boards), len(slices), len(ptrs), len(empty)) // sim:gomultidim stops here
+}
@@ -0,0 +1,12 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): cgo binaries linked against glibc can't reliably be fully static, so
+# this is built with zig cc targeting musl instead (set MUSL_CC to use something else,
+# i.e. musl-gcc). It opts out of go_variants.sh (see no_variants) because every
+# variant would be the same static binary.
+#
+
+set -x
+CGO_ENABLED=1 CGO_CFLAGS="-O0 -g" CC="${MUSL_CC:-zigup run $(cat ../../zig_version.txt) cc -target x86_64-linux-musl}" \
+    go build -gcflags="all=-N -l" -ldflags="-linkmode=external -extldflags=-static" -o out main.go
@@ -0,0 +1,44 @@
+// A cgo program that's fully statically linked against musl, so it has no
+// PT_INTERP, no dynamic section, and no shared libraries for the debugger
+// to discover
+package main
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include <unistd.h>
+
+static int compare_ints(const void *a, const void *b) {
+	int x = *(const int *)a;
+	int y = *(const int *)b;
+	return (x > y) - (x < y); // sim:gomusl stops here (qsort comparator in musl)
+}
+
+static void sort_ints(int *values, size_t count) {
+	qsort(values, count, sizeof(int), compare_ints);
+}
+
+static long c_getpid(void) {
+	return (long)getpid();
+}
+*/
+import "C"
+
+import (
+	"log"
+	"time"
+	"unsafe"
+)
+
+func main() {
+	values := []C.int{9, 3, 7, 1, 8, 2, 6, 4, 5}
+	C.sort_ints((*C.int)(unsafe.Pointer(&values[0])), C.size_t(len(values)))
+
+	name := C.CString("musl")
+	defer C.free(unsafe.Pointer(name))
+
+	for ndx := 0; ; ndx++ {
+		log.Printf("static musl cgo (pid %d, strlen %d): sorted %v, iteration %d", C.c_getpid(), C.strlen(name), values, ndx) // sim:gomusl stops here
+		time.Sleep(time.Second)
+	}
+}
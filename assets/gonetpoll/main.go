@@ -0,0 +1,115 @@
+// A program with goroutines parked in the netpoller: reads on TCP and unix
+// socket connections that never receive data, a blocked Accept, a read on a
+// pipe, and a read with a deadline that eventually fires
+package main
+
+import (
+	"errors"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+//go:noinline
+func readForever(name string, conn net.Conn, wg *sync.WaitGroup) {
+	defer wg.Done()
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf) // sim:gonetpoll blocks here (conn read)
+	log.Printf("%s: read %d bytes: %v", name, n, err)
+}
+
+//go:noinline
+func acceptForever(ln net.Listener, wg *sync.WaitGroup) {
+	defer wg.Done()
+	conn, err := ln.Accept() // sim:gonetpoll blocks here (accept)
+	if err == nil {
+		conn.Close()
+	}
+}
+
+//go:noinline
+func readPipe(r *os.File, wg *sync.WaitGroup) {
+	defer wg.Done()
+	buf := make([]byte, 64)
+	n, err := r.Read(buf) // sim:gonetpoll blocks here (pipe read)
+	log.Printf("pipe: read %d bytes: %v", n, err)
+}
+
+//go:noinline
+func readWithDeadline(conn net.Conn, wg *sync.WaitGroup) {
+	defer wg.Done()
+	conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+	_, err := conn.Read(make([]byte, 1))
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		log.Printf("deadline exceeded") // sim:gonetpoll stops here (deadline)
+	}
+}
+
+func main() {
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Fatalf("error listening on tcp: %v", err)
+	}
+	defer tcp.Close()
+
+	sock := filepath.Join(os.TempDir(), "gonetpoll.sock")
+	os.Remove(sock)
+	unix, err := net.Listen("unix", sock)
+	if err != nil {
+		log.Fatalf("error listening on unix socket: %v", err)
+	}
+	defer unix.Close()
+
+	var wg sync.WaitGroup
+	var conns []net.Conn
+	for _, ln := range []net.Listener{tcp, unix} {
+		client, err := net.Dial(ln.Addr().Network(), ln.Addr().String())
+		if err != nil {
+			log.Fatalf("error dialing %s: %v", ln.Addr(), err)
+		}
+		server, err := ln.Accept()
+		if err != nil {
+			log.Fatalf("error accepting on %s: %v", ln.Addr(), err)
+		}
+		conns = append(conns, client, server)
+
+		wg.Add(2)
+		go readForever(ln.Addr().Network()+" client", client, &wg)
+		go readForever(ln.Addr().Network()+" server", server, &wg)
+	}
+
+	wg.Add(1)
+	go acceptForever(tcp, &wg)
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		log.Fatalf("error creating pipe: %v", err)
+	}
+	wg.Add(1)
+	go readPipe(r, &wg)
+
+	// nothing accepts on the unix listener anymore, so this connection is only
+	// ever sitting in its backlog
+	deadlineClient, err := net.Dial("unix", sock)
+	if err != nil {
+		log.Fatalf("error dialing: %v", err)
+	}
+	wg.Add(1)
+	go readWithDeadline(deadlineClient, &wg)
+
+	time.Sleep(time.Second)
+	log.Printf("goroutines parked in the netpoller (pid: %d)", os.Getpid()) // sim:gonetpoll stops here
+
+	// unblock everything and exit
+	for _, conn := range conns {
+		conn.Close()
+	}
+	deadlineClient.Close()
+	w.Close()
+	tcp.Close()
+	wg.Wait()
+	os.Remove(sock)
+}
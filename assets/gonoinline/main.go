@@ -0,0 +1,56 @@
+// A program with a single call chain mixing //go:noinline, //go:nosplit, and
+// plain functions. Nosplit functions have no stack growth check in their
+// prologue, and small nosplit leaves may not even set up a frame pointer.
+package main
+
+import "log"
+
+type Acc struct {
+	Sum   int
+	Calls int
+}
+
+// a plain function that the compiler is free to inline (in optimized builds)
+func square(n int) int {
+	return n * n
+}
+
+//go:nosplit
+func nosplitLeaf(n int) int {
+	return n*3 + 1 // sim:gonoinline stops here (nosplit leaf)
+}
+
+//go:nosplit
+//go:noinline
+func nosplitMiddle(acc *Acc, n int) int {
+	acc.Calls++
+	return nosplitLeaf(n) + square(n)
+}
+
+//go:noinline
+func noinlineOuter(acc *Acc, n int) int {
+	v := nosplitMiddle(acc, n)
+	acc.Sum += v
+	return v // sim:gonoinline stops here (noinline caller of nosplit)
+}
+
+func plainTop(acc *Acc, values []int) {
+	for _, v := range values {
+		noinlineOuter(acc, v)
+	}
+}
+
+//go:noinline
+func recurseWithNosplit(acc *Acc, depth int) int {
+	if depth == 0 {
+		return nosplitLeaf(depth)
+	}
+	return nosplitMiddle(acc, depth) + recurseWithNosplit(acc, depth-1)
+}
+
+func main() {
+	var acc Acc
+	plainTop(&acc, []int{1, 2, 3, 4, 5})
+	deep := recurseWithNosplit(&acc, 50)
+	log.Printf("sum: %d, calls: %d, deep: %d", acc.Sum, acc.Calls, deep) // sim:gonoinline stops here
+}
@@ -0,0 +1,96 @@
+// A program with sync.Once-guarded initialization and lazily built global
+// caches, so the globals' values change meaningfully over the program's
+// life: zero before first use, partially built during initialization, and
+// populated afterwards
+package main
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+type Config struct {
+	Name    string
+	Retries int
+	Hosts   []string
+}
+
+var (
+	configOnce sync.Once
+	config     *Config
+	initCount  int
+
+	cacheMu sync.Mutex
+	cache   map[string]int
+
+	// sync.OnceValue and sync.OnceValues wrap a function and its results
+	primes = sync.OnceValue(func() []int {
+		var ps []int
+		for n := 2; len(ps) < 20; n++ {
+			isPrime := true
+			for _, p := range ps {
+				if n%p == 0 {
+					isPrime = false
+					break
+				}
+			}
+			if isPrime {
+				ps = append(ps, n)
+			}
+		}
+		return ps // sim:goonce stops here (inside sync.OnceValue)
+	})
+	parsed = sync.OnceValues(func() (int, error) {
+		var n int
+		_, err := fmt.Sscanf("1234", "%d", &n)
+		return n, err
+	})
+)
+
+//go:noinline
+func getConfig() *Config {
+	configOnce.Do(func() {
+		initCount++
+		c := &Config{Name: "lazy"}
+		c.Retries = 3 // sim:goonce stops here (during first use)
+		c.Hosts = []string{"a.example.com", "b.example.com"}
+		config = c
+	})
+	return config
+}
+
+//go:noinline
+func lookup(key string) int {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if cache == nil {
+		cache = map[string]int{}
+	}
+	if v, ok := cache[key]; ok {
+		return v
+	}
+	// derived from the key's last digit, so each entry shows which lookup filled it
+	v := (int(key[len(key)-1]-'0') + 1) * 100
+	cache[key] = v
+	return v
+}
+
+func main() {
+	log.Printf("before: config: %v, cache: %v, init count: %d", config, cache, initCount) // sim:goonce stops here (before first use)
+
+	var wg sync.WaitGroup
+	for ndx := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			getConfig()
+			lookup(fmt.Sprintf("key-%d", ndx%3))
+		}()
+	}
+	wg.Wait()
+
+	n, err := parsed()
+	log.Printf("after: config: %+v, cache: %v, init count: %d, primes: %v, parsed: %d (%v)", *config, cache, initCount, primes(), n, err) // sim:goonce stops here (after first use)
+}
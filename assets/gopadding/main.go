@@ -0,0 +1,91 @@
+// A program with structs whose field layouts force the compiler to insert
+// padding, so DWARF member offsets don't equal the running sum of the
+// preceding members' sizes
+package main
+
+import (
+	"log"
+	"unsafe"
+)
+
+// 7 bytes of padding after Flag, and 7 more after Done
+type BoolBetween struct {
+	A    int64
+	Flag bool
+	B    int64
+	Done bool
+}
+
+type OddArrays struct {
+	Tag   [3]byte
+	Count int32
+	Mark  [1]byte
+	Wide  int64
+	Tail  [5]byte
+}
+
+type Small struct {
+	A byte
+	B int16
+	C byte
+}
+
+// nested structs with trailing padding, embedded inside other structs
+type Nested struct {
+	Lead   byte
+	Inner  Small
+	Values [2]Small
+	Last   int32
+	Ptr    *Small
+	Byte   byte
+}
+
+type ZeroTail struct {
+	N     int32
+	Flag  bool
+	Empty struct{}
+}
+
+type Mixed struct {
+	A  uint8
+	B  uint64
+	C  uint16
+	D  float32
+	E  [3]uint16
+	F  complex64
+	G  bool
+	H  string
+	I  uint8
+	J  []byte
+	K  uint8
+	Z  ZeroTail
+	NS [2]Nested
+}
+
+func main() {
+	bb := BoolBetween{A: 1, Flag: true, B: 2, Done: true}
+	oa := OddArrays{Tag: [3]byte{'a', 'b', 'c'}, Count: 7, Mark: [1]byte{'!'}, Wide: -1, Tail: [5]byte{1, 2, 3, 4, 5}}
+	sm := Small{A: 0x11, B: 0x2222, C: 0x33}
+	ns := Nested{
+		Lead:   0xAA,
+		Inner:  sm,
+		Values: [2]Small{{A: 1, B: 2, C: 3}, {A: 4, B: 5, C: 6}},
+		Last:   99,
+		Ptr:    &sm,
+		Byte:   0xBB,
+	}
+	zt := ZeroTail{N: 5, Flag: true}
+	mx := Mixed{
+		A: 1, B: 2, C: 3, D: 4.5, E: [3]uint16{6, 7, 8}, F: complex(9, 10),
+		G: true, H: "padded", I: 11, J: []byte{12, 13}, K: 14, Z: zt,
+		NS: [2]Nested{ns, ns},
+	}
+	arr := [3]Small{sm, {A: 7, B: 8, C: 9}, sm}
+
+	log.Printf("BoolBetween: size %d, B at %d", unsafe.Sizeof(bb), unsafe.Offsetof(bb.B))
+	log.Printf("OddArrays: size %d, Count at %d, Wide at %d", unsafe.Sizeof(oa), unsafe.Offsetof(oa.Count), unsafe.Offsetof(oa.Wide))
+	log.Printf("Nested: size %d, Inner at %d, Last at %d", unsafe.Sizeof(ns), unsafe.Offsetof(ns.Inner), unsafe.Offsetof(ns.Last))
+	log.Printf("ZeroTail: size %d", unsafe.Sizeof(zt))
+	log.Printf("Mixed: size %d, NS at %d", unsafe.Sizeof(mx), unsafe.Offsetof(mx.NS))
+	log.Printf("[3]Small: size %d", unsafe.Sizeof(arr)) // sim:gopadding stops here
+}
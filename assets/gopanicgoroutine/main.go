@@ -0,0 +1,77 @@
+// A program where a worker goroutine (not main) panics. The first argument
+// selects whether the panic is recovered by the worker or takes down the
+// whole process:
+//
+//	$ ./out recovered
+//	$ ./out unrecovered
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type Job struct {
+	ID    int
+	Input []int
+}
+
+//go:noinline
+func process(job Job) int {
+	if job.ID == 3 {
+		panic(fmt.Sprintf("job %d: bad input %v", job.ID, job.Input)) // sim:gopanicgoroutine panics here
+	}
+
+	total := 0
+	for _, v := range job.Input {
+		total += v
+	}
+	return total
+}
+
+//go:noinline
+func worker(id int, jobs <-chan Job, wg *sync.WaitGroup, recovered bool) {
+	defer wg.Done()
+	if recovered {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("worker %d recovered: %v", id, r) // sim:gopanicgoroutine stops here (recovered)
+			}
+		}()
+	}
+
+	for job := range jobs {
+		log.Printf("worker %d: job %d = %d", id, job.ID, process(job))
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s <recovered|unrecovered>", os.Args[0])
+	}
+
+	mode := os.Args[1]
+	if mode != "recovered" && mode != "unrecovered" {
+		log.Fatalf("unknown mode: %s", mode)
+	}
+
+	jobs := make(chan Job)
+	var wg sync.WaitGroup
+	for id := range 3 {
+		wg.Add(1)
+		go worker(id, jobs, &wg, mode == "recovered")
+	}
+
+	for id := range 6 {
+		jobs <- Job{ID: id, Input: []int{id, id * 2, id * 3}}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(jobs)
+
+	// main is parked here while the worker panics
+	wg.Wait()
+	log.Printf("done")
+}
@@ -0,0 +1,58 @@
+// A program that recurses through a few mutually recursive functions to
+// build a stack hundreds of frames deep, then panics without recovering (the
+// runtime's own traceback elides the middle of the stack). Pass the depth as
+// the first argument (default 500):
+//
+//	$ ./out
+//	$ ./out 5000
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+type Frame struct {
+	Depth int
+	Label string
+}
+
+//go:noinline
+func descendA(f Frame, max int) int {
+	if f.Depth >= max {
+		panic(fmt.Sprintf("reached depth %d", f.Depth)) // sim:gopanicstack panics here
+	}
+	return descendB(Frame{Depth: f.Depth + 1, Label: "b"}, max) + 1
+}
+
+//go:noinline
+func descendB(f Frame, max int) int {
+	var pad [16]int64
+	pad[f.Depth%len(pad)] = int64(f.Depth)
+	return descendC(Frame{Depth: f.Depth + 1, Label: "c"}, max) + int(pad[0])
+}
+
+//go:noinline
+func descendC(f Frame, max int) int {
+	// a closure frame in the middle of every cycle
+	next := func() int {
+		return descendA(Frame{Depth: f.Depth + 1, Label: "a"}, max)
+	}
+	return next() + 1
+}
+
+func main() {
+	depth := 500
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid depth %q: %v", os.Args[1], err)
+		}
+		depth = n
+	}
+
+	log.Printf("descending to depth %d", depth)
+	log.Println(descendA(Frame{Depth: 0, Label: "a"}, depth))
+}
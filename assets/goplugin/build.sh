@@ -0,0 +1,12 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): the plugin and the host must be built with identical flags, otherwise
+# plugin.Open fails with "plugin was built with a different version of package ...".
+# This asset therefore opts out of go_variants.sh (see no_variants).
+#
+
+set -x
+GCFLAGS="all=-N -l"
+go build -gcflags="$GCFLAGS" -buildmode=plugin -o plugin.so plugin/plugin.go
+go build -gcflags="$GCFLAGS" -o out main.go
@@ -0,0 +1,5 @@
+#!/usr/bin/env bash
+
+set -x
+rm -f out
+rm -f *.so
@@ -0,0 +1,56 @@
+// A program that loads a Go plugin (plugin.so, built with -buildmode=plugin)
+// after it has started running, then calls in to the plugin's code
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"plugin"
+	"time"
+)
+
+type greeter interface {
+	Greet(name string) string
+}
+
+func main() {
+	log.Printf("goplugin (pid: %d) started", os.Getpid())
+
+	// give the debugger a chance to observe the process before the plugin is mapped
+	time.Sleep(time.Second)
+
+	self, err := os.Executable()
+	if err != nil {
+		log.Fatalf("failed to find executable: %v", err)
+	}
+
+	path := filepath.Join(filepath.Dir(self), "plugin.so")
+	p, err := plugin.Open(path)
+	if err != nil {
+		log.Fatalf("failed to open plugin %s: %v", path, err)
+	}
+	log.Printf("loaded plugin: %s", path) // sim:goplugin stops here (plugin mapped)
+
+	version, err := p.Lookup("Version")
+	if err != nil {
+		log.Fatalf("failed to look up Version: %v", err)
+	}
+	log.Printf("plugin version: %s", *version.(*string))
+
+	transform, err := p.Lookup("Transform")
+	if err != nil {
+		log.Fatalf("failed to look up Transform: %v", err)
+	}
+	res := transform.(func([]int) []int)([]int{1, 2, 3})
+	log.Printf("transform: %v", res)
+
+	sym, err := p.Lookup("DefaultGreeter")
+	if err != nil {
+		log.Fatalf("failed to look up DefaultGreeter: %v", err)
+	}
+	g := sym.(greeter)
+	for _, name := range []string{"alice", "bob"} {
+		log.Println(g.Greet(name))
+	}
+}
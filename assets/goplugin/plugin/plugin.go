@@ -0,0 +1,34 @@
+// The plugin loaded at runtime by goplugin. It's built with -buildmode=plugin,
+// so it must be package main even though it has no main function.
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Greeter struct {
+	Prefix string
+	Count  int
+}
+
+func (g *Greeter) Greet(name string) string {
+	g.Count++
+	msg := fmt.Sprintf("%s, %s! (greeting #%d)", g.Prefix, strings.ToUpper(name), g.Count)
+	return msg // sim:goplugin stops here (plugin method)
+}
+
+// Version is an exported variable that lives in the plugin's data segment
+var Version = "plugin-v1"
+
+// DefaultGreeter is an exported variable that the host mutates through a pointer
+var DefaultGreeter = Greeter{Prefix: "hello"}
+
+// Transform is an exported function that the host looks up by name
+func Transform(vals []int) []int {
+	out := make([]int, len(vals))
+	for ndx, v := range vals {
+		out[ndx] = v*v + 1
+	}
+	return out // sim:goplugin stops here (plugin function)
+}
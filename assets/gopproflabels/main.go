@@ -0,0 +1,88 @@
+// A program with several goroutines doing distinct work, each tagged with
+// runtime/pprof labels (the labels are stored on the runtime's g struct)
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"log"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+)
+
+//go:noinline
+func hashWork(ctx context.Context, done <-chan struct{}) {
+	sum := sha256.Sum256([]byte("uscope"))
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		sum = sha256.Sum256(sum[:])
+		time.Sleep(time.Millisecond)
+	}
+}
+
+//go:noinline
+func sortWork(ctx context.Context, done <-chan struct{}) {
+	nums := make([]int, 1024)
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		for ndx := range nums {
+			nums[ndx] = (ndx * 7919) % len(nums)
+		}
+		sort.Ints(nums)
+		time.Sleep(time.Millisecond)
+	}
+}
+
+//go:noinline
+func idleWork(ctx context.Context, done <-chan struct{}) {
+	<-done
+}
+
+func main() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	workers := []struct {
+		labels pprof.LabelSet
+		fn     func(context.Context, <-chan struct{})
+	}{
+		{pprof.Labels("worker", "hasher", "id", "1"), hashWork},
+		{pprof.Labels("worker", "sorter", "id", "2"), sortWork},
+		{pprof.Labels("worker", "sorter", "id", "3", "tenant", "acme"), sortWork},
+		{pprof.Labels("worker", "idle"), idleWork},
+	}
+
+	for _, w := range workers {
+		wg.Add(1)
+		go pprof.Do(context.Background(), w.labels, func(ctx context.Context) {
+			defer wg.Done()
+			w.fn(ctx, done)
+		})
+	}
+
+	// an unlabeled goroutine for comparison
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		idleWork(context.Background(), done)
+	}()
+
+	// main sets labels on itself as well
+	pprof.Do(context.Background(), pprof.Labels("worker", "main"), func(ctx context.Context) {
+		time.Sleep(500 * time.Millisecond) // sim:gopproflabels stops here
+	})
+
+	close(done)
+	wg.Wait()
+	log.Printf("done")
+}
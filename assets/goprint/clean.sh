@@ -1,4 +1,4 @@
 #!/usr/bin/env bash
 
 set -x
-rm -f out
+rm -f out out_*
@@ -0,0 +1,55 @@
+// A program that recurses thousands of frames deep before stopping, where
+// each frame has distinguishable arguments and locals
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+const defaultDepth = 5000
+
+type Frame struct {
+	Depth int
+	Label string
+}
+
+//go:noinline
+func recurse(depth, max int, parent *Frame) int {
+	frame := Frame{
+		Depth: depth,
+		Label: fmt.Sprintf("frame-%d", depth),
+	}
+	if depth >= max {
+		log.Printf("reached max depth %d (parent: %s)", depth, parent.Label) // sim:gorecursion stops here
+		return depth
+	}
+
+	// alternate between two functions so the backtrace isn't uniform
+	if depth%2 == 0 {
+		return recurse(depth+1, max, &frame) + 1
+	}
+	return mutual(depth+1, max, &frame) + 1
+}
+
+//go:noinline
+func mutual(depth, max int, parent *Frame) int {
+	return recurse(depth, max, parent)
+}
+
+func main() {
+	max := defaultDepth
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid depth %q: %v", os.Args[1], err)
+		}
+		max = n
+	}
+
+	root := Frame{Depth: 0, Label: "root"}
+	res := recurse(1, max, &root)
+	log.Printf("done: %d", res)
+}
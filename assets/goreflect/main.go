@@ -0,0 +1,87 @@
+// A program that constructs, inspects, and mutates values via reflection
+package main
+
+import (
+	"log"
+	"reflect"
+	"strings"
+)
+
+type Config struct {
+	Name    string `json:"name" env:"APP_NAME"`
+	Port    int    `json:"port" env:"APP_PORT"`
+	Verbose bool   `json:"verbose"`
+	Tags    []string
+	private int
+}
+
+func (c Config) Describe(prefix string) string {
+	return prefix + c.Name
+}
+
+//go:noinline
+func setField(v reflect.Value, name string, val any) {
+	field := v.FieldByName(name)
+	newVal := reflect.ValueOf(val)
+	field.Set(newVal) // sim:goreflect stops here (inside reflective mutation)
+}
+
+//go:noinline
+func walk(v reflect.Value, depth int) {
+	t := v.Type()
+	indent := strings.Repeat("  ", depth)
+	for ndx := range t.NumField() {
+		field := t.Field(ndx)
+		if !field.IsExported() {
+			log.Printf("%s%s (unexported %s)", indent, field.Name, field.Type)
+			continue
+		}
+
+		fv := v.Field(ndx)
+		log.Printf("%s%s %s = %v (tag: %q)", indent, field.Name, field.Type, fv.Interface(), field.Tag)
+	}
+}
+
+func main() {
+	cfg := &Config{Name: "app", Port: 80, private: 7}
+
+	v := reflect.ValueOf(cfg).Elem()
+	typ := v.Type()
+	kind := typ.Kind()
+
+	setField(v, "Name", "reflected")
+	setField(v, "Port", 8080)
+	setField(v, "Tags", []string{"a", "b"})
+	walk(v, 0)
+
+	// build containers from scratch
+	sliceType := reflect.SliceOf(reflect.TypeOf(0))
+	slice := reflect.MakeSlice(sliceType, 0, 4)
+	for ndx := range 4 {
+		slice = reflect.Append(slice, reflect.ValueOf(ndx*ndx))
+	}
+
+	mapType := reflect.MapOf(reflect.TypeOf(""), reflect.TypeOf(Config{}))
+	m := reflect.MakeMap(mapType)
+	m.SetMapIndex(reflect.ValueOf("primary"), v)
+
+	newPtr := reflect.New(typ)
+	newPtr.Elem().FieldByName("Verbose").SetBool(true)
+
+	// call a method and a function reflectively
+	method := reflect.ValueOf(*cfg).MethodByName("Describe")
+	out := method.Call([]reflect.Value{reflect.ValueOf("config: ")})
+
+	join := reflect.ValueOf(strings.Join)
+	joined := join.Call([]reflect.Value{reflect.ValueOf(cfg.Tags), reflect.ValueOf(",")})
+
+	var zero reflect.Value
+	var iface any = cfg
+	ifaceVal := reflect.ValueOf(&iface).Elem()
+
+	log.Printf("kind: %v, type: %v", kind, typ) // sim:goreflect stops here
+	log.Printf("slice: %v, map: %v", slice.Interface(), m.Interface())
+	log.Printf("new: %+v", newPtr.Interface())
+	log.Printf("method: %v, joined: %v", out[0], joined[0])
+	log.Printf("zero valid: %v, iface kind: %v", zero.IsValid(), ifaceVal.Kind())
+}
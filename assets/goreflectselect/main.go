@@ -0,0 +1,105 @@
+// A program that builds select statements at runtime with reflect.Select
+// across many channels: a dispatcher receiving from a dynamic set of
+// producer channels (removing cases as channels close), a goroutine blocked
+// in a reflect.Select with no ready cases, and a select mixing send and
+// receive cases with a default
+package main
+
+import (
+	"log"
+	"reflect"
+	"sync"
+	"time"
+)
+
+type Message struct {
+	Source int
+	Seq    int
+}
+
+//go:noinline
+func producer(id, count int, out chan<- Message) {
+	for seq := range count {
+		out <- Message{Source: id, Seq: seq}
+		time.Sleep(time.Duration(id+1) * time.Millisecond)
+	}
+	close(out)
+}
+
+//go:noinline
+func dispatch(chans []chan Message) map[int]int {
+	cases := make([]reflect.SelectCase, len(chans))
+	for ndx, ch := range chans {
+		cases[ndx] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+
+	counts := map[int]int{}
+	for len(cases) > 0 {
+		chosen, value, ok := reflect.Select(cases)
+		if !ok {
+			// this channel was closed, so stop selecting on it
+			cases = append(cases[:chosen], cases[chosen+1:]...)
+			continue
+		}
+
+		msg := value.Interface().(Message)
+		counts[msg.Source]++ // sim:goreflectselect stops here (dynamic receive)
+	}
+	return counts
+}
+
+//go:noinline
+func blockedSelect(chans []chan int, wg *sync.WaitGroup) {
+	defer wg.Done()
+	cases := make([]reflect.SelectCase, len(chans))
+	for ndx, ch := range chans {
+		cases[ndx] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+	chosen, value, _ := reflect.Select(cases) // sim:goreflectselect blocks here (no ready cases)
+	log.Printf("blocked select woke on case %d: %v", chosen, value)
+}
+
+//go:noinline
+func mixedSelect(send chan<- int, recv <-chan string) string {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: reflect.ValueOf(send), Send: reflect.ValueOf(42)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(recv)},
+		{Dir: reflect.SelectDefault},
+	}
+	chosen, value, _ := reflect.Select(cases)
+	switch chosen {
+	case 0:
+		return "sent"
+	case 1:
+		return "received " + value.String()
+	}
+	return "default" // sim:goreflectselect stops here (default case)
+}
+
+func main() {
+	const producers = 32
+	chans := make([]chan Message, producers)
+	for ndx := range chans {
+		chans[ndx] = make(chan Message, ndx%4)
+		go producer(ndx, 5+ndx%7, chans[ndx])
+	}
+
+	idle := make([]chan int, 16)
+	for ndx := range idle {
+		idle[ndx] = make(chan int)
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go blockedSelect(idle, &wg)
+
+	counts := dispatch(chans)
+
+	full := make(chan int)
+	empty := make(chan string)
+	mixed := mixedSelect(full, empty)
+
+	log.Printf("sources: %d, mixed: %s", len(counts), mixed) // sim:goreflectselect stops here
+
+	idle[7] <- 7
+	wg.Wait()
+}
@@ -0,0 +1,78 @@
+// A program with functions that take more arguments than the register ABI
+// has room for (9 integer and 15 floating point registers), so some of them
+// are passed on the stack. Mixed argument types use up the integer
+// registers at different rates: a string takes two, a slice three, an
+// interface two, and a struct one per field, while arrays of more than one
+// element are always passed on the stack.
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+type Point struct {
+	X, Y int
+}
+
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+//go:noinline
+func manyInts(a, b, c, d, e, f, g, h, i, j, k, l int) int {
+	return a + b + c + d + e + f + g + h + i + j + k + l // sim:goregargs stops here (12 ints)
+}
+
+//go:noinline
+func manyFloats(a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q float64) float64 {
+	return a + b + c + d + e + f + g + h + i + j + k + l + m + n + o + p + q // sim:goregargs stops here (17 floats)
+}
+
+//go:noinline
+func mixed(
+	flag bool,
+	count int8,
+	ratio float32,
+	name string,
+	values []int,
+	origin Point,
+	dir Vec3,
+	err error,
+	stringer fmt.Stringer,
+	grid [2]int,
+	ptr *Point,
+	cb func(int) int,
+	tail uint64,
+) string {
+	total := int(count) + len(name) + len(values) + origin.X + origin.Y + grid[0] + grid[1] + ptr.X + cb(int(tail)) // sim:goregargs stops here (mixed)
+	if flag {
+		total *= 2
+	}
+	return fmt.Sprintf("%d %.2f %.2f %v %s", total, ratio, dir.X+dir.Y+dir.Z, err, stringer)
+}
+
+type label string
+
+func (l label) String() string { return "label:" + string(l) }
+
+func main() {
+	ints := manyInts(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+	floats := manyFloats(0.5, 1.5, 2.5, 3.5, 4.5, 5.5, 6.5, 7.5, 8.5, 9.5, 10.5, 11.5, 12.5, 13.5, 14.5, 15.5, 16.5)
+	s := mixed(
+		true,
+		-3,
+		0.25,
+		"regargs",
+		[]int{1, 2, 3},
+		Point{X: 10, Y: 20},
+		Vec3{X: 1, Y: 2, Z: 3},
+		fmt.Errorf("an error"),
+		label("x"),
+		[2]int{100, 200},
+		&Point{X: 1000},
+		func(n int) int { return n * 3 },
+		1<<40+7,
+	)
+	log.Printf("ints: %d, floats: %.1f, mixed: %s", ints, floats, s)
+}
@@ -0,0 +1,99 @@
+// A program with named return values that are modified by deferred
+// functions, and functions that return two to four values
+package main
+
+import (
+	"errors"
+	"log"
+)
+
+type Point struct {
+	X, Y int
+}
+
+//go:noinline
+func divmod(a, b int) (int, int) {
+	q, r := a/b, a%b
+	return q, r // sim:goreturns stops here (two values)
+}
+
+//go:noinline
+func minMaxSum(vals []int) (min, max, sum int) {
+	min, max = vals[0], vals[0]
+	for _, v := range vals {
+		min = minInt(min, v)
+		max = maxInt(max, v)
+		sum += v
+	}
+	return // sim:goreturns stops here (three named values)
+}
+
+//go:noinline
+func four(n int) (Point, string, error, bool) {
+	p := Point{X: n, Y: -n}
+	return p, "four", nil, n%2 == 0 // sim:goreturns stops here (four values)
+}
+
+// the deferred function overwrites the named results after the return
+// statement has assigned them
+//
+//go:noinline
+func deferModifies(n int) (result int, err error) {
+	defer func() {
+		result *= 2
+		if result > 10 {
+			err = errors.New("result too large")
+		}
+	}()
+
+	result = n + 1
+	return result, nil // sim:goreturns stops here (before defer runs)
+}
+
+// the deferred function recovers from a panic and sets the named results
+//
+//go:noinline
+func deferRecovers() (val string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			val = "recovered"
+			err = errors.New("panicked")
+		}
+	}()
+
+	val = "unreachable"
+	panic("boom")
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func main() {
+	q, r := divmod(17, 5)
+	log.Printf("divmod: %d, %d", q, r)
+
+	lo, hi, sum := minMaxSum([]int{4, -2, 9, 7})
+	log.Printf("minMaxSum: %d, %d, %d", lo, hi, sum)
+
+	p, s, err, even := four(4)
+	log.Printf("four: %v, %s, %v, %v", p, s, err, even)
+
+	res, err := deferModifies(2)
+	log.Printf("deferModifies(2): %d, %v", res, err)
+	res, err = deferModifies(9)
+	log.Printf("deferModifies(9): %d, %v", res, err)
+
+	val, err := deferRecovers()
+	log.Printf("deferRecovers: %s, %v", val, err)
+}
@@ -0,0 +1,53 @@
+// A program that calls runtime.Breakpoint() at several points, including from
+// inside a goroutine. Each call executes an INT3 that the debugger didn't plant.
+//
+// @NOTE (jrc): when run outside of a debugger, the first runtime.Breakpoint()
+// kills the process with SIGTRAP
+package main
+
+import (
+	"log"
+	"runtime"
+	"sync"
+)
+
+type State struct {
+	Phase string
+	Count int
+}
+
+//go:noinline
+func checkpoint(s *State, phase string) {
+	s.Phase = phase
+	s.Count++
+	runtime.Breakpoint() // sim:goruntimebreak stops here (in a function)
+}
+
+func main() {
+	s := &State{}
+	log.Printf("goruntimebreak starting")
+
+	runtime.Breakpoint() // sim:goruntimebreak stops here (first statement in main)
+
+	checkpoint(s, "before goroutine")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		local := State{Phase: "goroutine", Count: 100}
+		runtime.Breakpoint() // sim:goruntimebreak stops here (in a goroutine)
+		checkpoint(&local, "inside goroutine")
+	}()
+	wg.Wait()
+
+	for ndx := range 3 {
+		if ndx == 2 {
+			runtime.Breakpoint() // sim:goruntimebreak stops here (conditionally, in a loop)
+		}
+		s.Count += ndx
+	}
+
+	log.Printf("done: %+v", *s)
+}
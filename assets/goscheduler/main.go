@@ -0,0 +1,74 @@
+// A program that runs the same CPU-bound workload with GOMAXPROCS=1 and then
+// GOMAXPROCS=NumCPU. The workload is made of tight loops with no function calls,
+// so the scheduler can only preempt them asynchronously by sending SIGURG to
+// the threads running them.
+package main
+
+import (
+	"log"
+	"runtime"
+	"sync"
+	"time"
+)
+
+const goroutines = 32
+
+// spin contains no function calls or other cooperative preemption points
+//
+//go:noinline
+func spin(iters int) uint64 {
+	x := uint64(1)
+	for ndx := 0; ndx < iters; ndx++ {
+		x = x*6364136223846793005 + 1442695040888963407 // sim:goscheduler stops here
+	}
+	return x
+}
+
+func run(procs int) {
+	prev := runtime.GOMAXPROCS(procs)
+	defer runtime.GOMAXPROCS(prev)
+
+	start := time.Now()
+	results := make([]uint64, goroutines)
+
+	var wg sync.WaitGroup
+	for id := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[id] = spin(50_000_000)
+		}()
+	}
+
+	// the ticker goroutine only gets to run if the spinning goroutines are preempted
+	done := make(chan struct{})
+	ticks := make(chan int)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		n := 0
+		for {
+			select {
+			case <-ticker.C:
+				n++
+			case <-done:
+				ticks <- n
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+
+	log.Printf("GOMAXPROCS=%d: %d goroutines finished in %s (ticker ran %d times)",
+		procs, goroutines, time.Since(start), <-ticks)
+}
+
+func main() {
+	log.Printf("NumCPU: %d", runtime.NumCPU())
+
+	run(1)
+	run(runtime.NumCPU())
+}
@@ -0,0 +1,67 @@
+// A program that redeclares the same identifiers in nested blocks, loops, and
+// if/switch initializers. Each stop should only show the innermost x and err.
+package main
+
+import (
+	"errors"
+	"log"
+	"strconv"
+)
+
+var x = "package-level x"
+
+//go:noinline
+func parse(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+//go:noinline
+func packageScope() string {
+	return x // sim:goshadow stops here (x="package-level x")
+}
+
+func main() {
+	log.Printf("package scope: x=%v", packageScope())
+
+	x := 1
+	err := errors.New("outer err")
+	log.Printf("function scope: x=%v, err=%v", x, err) // sim:goshadow stops here (x=1)
+
+	{
+		x := "block"
+		log.Printf("block scope: x=%v", x) // sim:goshadow stops here (x="block")
+
+		{
+			x := 2.5
+			log.Printf("nested block scope: x=%v", x) // sim:goshadow stops here (x=2.5)
+		}
+	}
+
+	for x := 10; x < 12; x++ {
+		x := x * 100
+		log.Printf("loop body scope: x=%v", x) // sim:goshadow stops here (x=1000, 1100)
+	}
+
+	if x, err := parse("42"); err == nil {
+		log.Printf("if initializer scope: x=%v, err=%v", x, err) // sim:goshadow stops here (x=42)
+	} else if x, err := parse("nope"); err != nil {
+		log.Printf("else-if initializer scope: x=%v, err=%v", x, err)
+	}
+
+	if _, err := parse("nope"); err != nil {
+		log.Printf("if initializer scope: err=%v", err) // sim:goshadow stops here (parse error)
+	}
+
+	switch x := x + 5; {
+	case x > 5:
+		x := []int{x}
+		log.Printf("switch case scope: x=%v", x) // sim:goshadow stops here (x=[6])
+	}
+
+	func() {
+		x := struct{ Inner int }{Inner: 3}
+		log.Printf("closure scope: x=%v", x) // sim:goshadow stops here (x={3})
+	}()
+
+	log.Printf("back in function scope: x=%v, err=%v", x, err) // sim:goshadow stops here (x=1)
+}
@@ -0,0 +1,129 @@
+// A cgo program that installs its own SIGUSR2 handler with SA_ONSTACK, and
+// a C thread that sets up its own alternate signal stack with sigaltstack.
+// Signals are delivered both to the C thread (running on the stack it
+// allocated) and to the process as a whole (usually landing on a Go thread,
+// which runs the handler on the runtime's gsignal stack) while Go code runs.
+package main
+
+/*
+#include <pthread.h>
+#include <semaphore.h>
+#include <signal.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+#include <unistd.h>
+
+static volatile sig_atomic_t c_thread_handled = 0;
+static volatile sig_atomic_t other_handled = 0;
+static volatile int running = 1;
+static pthread_t c_thread;
+static sem_t c_thread_ready;
+static void *alt_stack = NULL;
+
+static void on_alt_stack(int on_c_thread) {
+	volatile uintptr_t marker = (uintptr_t)&marker;
+	(void)marker;
+	if (on_c_thread) {
+		c_thread_handled++; // sim:gosigaltstack stops here (handler on the C thread's alternate stack)
+	} else {
+		other_handled++; // sim:gosigaltstack stops here (handler on a Go thread's gsignal stack)
+	}
+}
+
+static void handler(int sig) {
+	(void)sig;
+	on_alt_stack(pthread_equal(pthread_self(), c_thread));
+}
+
+static void *c_thread_main(void *arg) {
+	(void)arg;
+
+	stack_t ss;
+	memset(&ss, 0, sizeof(ss));
+	alt_stack = malloc(SIGSTKSZ * 4);
+	ss.ss_sp = alt_stack;
+	ss.ss_size = SIGSTKSZ * 4;
+	sigaltstack(&ss, NULL);
+	sem_post(&c_thread_ready);
+
+	volatile uint64_t spins = 0;
+	while (running) {
+		spins++;
+	}
+	return NULL;
+}
+
+static int install(void) {
+	struct sigaction sa;
+	memset(&sa, 0, sizeof(sa));
+	sa.sa_handler = handler;
+	sa.sa_flags = SA_ONSTACK | SA_RESTART;
+	sigemptyset(&sa.sa_mask);
+	if (sigaction(SIGUSR2, &sa, NULL) != 0) {
+		return -1;
+	}
+	if (sem_init(&c_thread_ready, 0, 0) != 0) {
+		return -1;
+	}
+	if (pthread_create(&c_thread, NULL, c_thread_main, NULL) != 0) {
+		return -1;
+	}
+
+	// don't signal the C thread until its alternate stack is installed (sem_wait
+	// fails with EINTR if one of the runtime's signals arrives while waiting)
+	while (sem_wait(&c_thread_ready) != 0) {
+	}
+	sem_destroy(&c_thread_ready);
+	return 0;
+}
+
+static void signal_c_thread(void) {
+	pthread_kill(c_thread, SIGUSR2);
+}
+
+static void signal_process(void) {
+	kill(getpid(), SIGUSR2);
+}
+
+static void stop(void) {
+	running = 0;
+	pthread_join(c_thread, NULL);
+	free(alt_stack);
+}
+
+static int c_thread_count(void) { return c_thread_handled; }
+static int other_count(void) { return other_handled; }
+*/
+import "C"
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+//go:noinline
+func busyGo(d time.Duration) int {
+	n := 0
+	for start := time.Now(); time.Since(start) < d; {
+		n++ // sim:gosigaltstack stops here (Go code interrupted by a signal)
+	}
+	return n
+}
+
+func main() {
+	if C.install() != 0 {
+		log.Fatalf("error installing the signal handler")
+	}
+	log.Printf("pid: %d", os.Getpid())
+
+	for round := range 10 {
+		C.signal_c_thread()
+		C.signal_process()
+		n := busyGo(50 * time.Millisecond)
+		log.Printf("round %d: busy loop %d, handled on c thread: %d, elsewhere: %d", round, n, C.c_thread_count(), C.other_count())
+	}
+
+	C.stop()
+}
@@ -0,0 +1,75 @@
+// A program that spawns short-lived child processes and reaps them with
+// wait4 from a SIGCHLD-driven loop, rather than through os/exec's Wait. This
+// competes with a debugger's own use of waitpid on the same process tree.
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+type Reaped struct {
+	Pid    int
+	Status syscall.WaitStatus
+}
+
+//go:noinline
+func reapAll() []Reaped {
+	var reaped []Reaped
+	for {
+		var status syscall.WaitStatus
+		pid, err := syscall.Wait4(-1, &status, syscall.WNOHANG, nil)
+		if pid <= 0 || err != nil {
+			return reaped
+		}
+		reaped = append(reaped, Reaped{Pid: pid, Status: status}) // sim:gosigchld stops here (reaped a child)
+	}
+}
+
+//go:noinline
+func spawn(code int, delay string) int {
+	attr := &syscall.ProcAttr{Files: []uintptr{0, 1, 2}}
+	script := "sleep " + delay + "; exit " + strconv.Itoa(code)
+	pid, err := syscall.ForkExec("/bin/sh", []string{"sh", "-c", script}, attr)
+	if err != nil {
+		log.Fatalf("error spawning child: %v", err)
+	}
+	return pid
+}
+
+func main() {
+	sigs := make(chan os.Signal, 16)
+	signal.Notify(sigs, syscall.SIGCHLD)
+
+	const children = 8
+	spawned := map[int]bool{}
+	for ndx := range children {
+		pid := spawn(ndx%4, []string{"0", "0.1", "0.2", "0.3"}[ndx%4])
+		spawned[pid] = true
+	}
+	log.Printf("spawned %d children (pid: %d)", len(spawned), os.Getpid())
+
+	// signals coalesce, so every SIGCHLD reaps as many children as are ready
+	remaining := len(spawned)
+	timeout := time.After(10 * time.Second)
+	for remaining > 0 {
+		select {
+		case <-sigs:
+			for _, r := range reapAll() {
+				if !spawned[r.Pid] {
+					log.Printf("reaped unknown child %d", r.Pid)
+					continue
+				}
+				remaining--
+				log.Printf("reaped child %d: exit status %d (%d remaining)", r.Pid, r.Status.ExitStatus(), remaining) // sim:gosigchld stops here
+			}
+		case <-timeout:
+			log.Fatalf("timed out with %d children remaining", remaining)
+		}
+	}
+	log.Printf("all children reaped")
+}
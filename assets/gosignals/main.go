@@ -0,0 +1,70 @@
+// A program that installs handlers for SIGUSR1, SIGUSR2, and SIGTERM and
+// periodically sends them to itself. Signals may also be sent externally:
+//
+//	$ kill -USR1 <pid>
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+type Stats struct {
+	Usr1 int
+	Usr2 int
+	Term int
+}
+
+//go:noinline
+func handle(sig os.Signal, stats *Stats) bool {
+	switch sig {
+	case syscall.SIGUSR1:
+		stats.Usr1++
+	case syscall.SIGUSR2:
+		stats.Usr2++
+	case syscall.SIGTERM:
+		stats.Term++
+		return true
+	}
+
+	log.Printf("received %v: %+v", sig, *stats) // sim:gosignals stops here
+	return false
+}
+
+func main() {
+	pid := os.Getpid()
+	log.Printf("gosignals pid: %d", pid)
+
+	sigs := make(chan os.Signal, 8)
+	signal.Notify(sigs, syscall.SIGUSR1, syscall.SIGUSR2, syscall.SIGTERM)
+
+	// send ourselves signals on a schedule; SIGTERM is sent last and ends the program
+	go func() {
+		for ndx := 1; ndx <= 10; ndx++ {
+			time.Sleep(500 * time.Millisecond)
+
+			sig := syscall.SIGUSR1
+			if ndx%2 == 0 {
+				sig = syscall.SIGUSR2
+			}
+			if ndx == 10 {
+				sig = syscall.SIGTERM
+			}
+			if err := syscall.Kill(pid, sig); err != nil {
+				log.Fatalf("failed to send %v: %v", sig, err)
+			}
+		}
+	}()
+
+	stats := Stats{}
+	for sig := range sigs {
+		if handle(sig, &stats) {
+			break
+		}
+	}
+
+	log.Printf("exiting after SIGTERM: %+v", stats)
+}
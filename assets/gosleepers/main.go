@@ -0,0 +1,84 @@
+// A program that parks tens of thousands of goroutines, mostly blocked on
+// channel receives, with a handful blocked in time.Sleep, a mutex, and a
+// select. Pass the goroutine count as the first argument (default 50,000):
+//
+//	$ ./out
+//	$ ./out 100000
+package main
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//go:noinline
+func waitOnChannel(id int, ch <-chan int, started *sync.WaitGroup) {
+	started.Done()
+	<-ch
+}
+
+//go:noinline
+func waitOnSleep(id int, started *sync.WaitGroup) {
+	started.Done()
+	time.Sleep(time.Hour)
+}
+
+//go:noinline
+func waitOnMutex(id int, mu *sync.Mutex, started *sync.WaitGroup) {
+	started.Done()
+	mu.Lock()
+	mu.Unlock()
+}
+
+//go:noinline
+func waitOnSelect(id int, a, b <-chan int, started *sync.WaitGroup) {
+	started.Done()
+	select {
+	case <-a:
+	case <-b:
+	}
+}
+
+func main() {
+	count := 50_000
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid goroutine count %q: %v", os.Args[1], err)
+		}
+		count = n
+	}
+
+	start := time.Now()
+	release := make(chan int)
+	never := make(chan int)
+	var mu sync.Mutex
+	mu.Lock()
+
+	var started sync.WaitGroup
+	started.Add(count)
+	for id := range count {
+		switch {
+		case id%1000 == 1:
+			go waitOnSleep(id, &started)
+		case id%1000 == 2:
+			go waitOnMutex(id, &mu, &started)
+		case id%1000 == 3:
+			go waitOnSelect(id, release, never, &started)
+		default:
+			go waitOnChannel(id, release, &started)
+		}
+	}
+	started.Wait()
+
+	log.Printf("parked %d goroutines in %s (pid: %d)", count, time.Since(start).Round(time.Millisecond), os.Getpid())
+	time.Sleep(time.Second) // sim:gosleepers stops here
+
+	// wake everything except the sleepers and exit
+	close(release)
+	mu.Unlock()
+	log.Printf("released")
+}
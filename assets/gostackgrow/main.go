@@ -0,0 +1,49 @@
+// A program that forces a goroutine's stack to grow (runtime.morestack
+// followed by a stack copy) while pointers to stack-allocated locals are live,
+// so the addresses of those locals change between the two stops
+package main
+
+import (
+	"log"
+	"sync"
+	"unsafe"
+)
+
+type Point struct {
+	X, Y int
+}
+
+// bigFrame has a large enough frame that calling it from a fresh goroutine
+// (which starts with a small stack) is guaranteed to trigger a stack copy
+//
+//go:noinline
+func bigFrame(p *Point) int {
+	var scratch [64 * 1024]byte
+	for i := range scratch {
+		scratch[i] = byte(i)
+	}
+	p.X += int(scratch[len(scratch)-1])
+	return p.X + p.Y
+}
+
+//go:noinline
+func grow(wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	local := Point{X: 1, Y: 2}
+	ptr := &local
+	before := uintptr(unsafe.Pointer(ptr))
+	log.Printf("before stack copy: %#x", before) // sim:gostackgrow stops here (before copy)
+
+	sum := bigFrame(ptr)
+
+	after := uintptr(unsafe.Pointer(ptr))
+	log.Printf("after stack copy: %#x (moved: %v, sum: %d)", after, before != after, sum) // sim:gostackgrow stops here (after copy)
+}
+
+func main() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go grow(&wg)
+	wg.Wait()
+}
@@ -0,0 +1,43 @@
+// An interactive program that prompts for and reads lines from stdin,
+// echoing each one back until EOF or "quit" is entered
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+//go:noinline
+func echo(ndx int, line string) {
+	trimmed := strings.TrimSpace(line)
+	fmt.Printf("[%d] you said: %q (%d bytes)\n", ndx, trimmed, len(line)) // sim:gostdin stops here
+}
+
+func main() {
+	log.Printf("gostdin pid: %d", os.Getpid())
+
+	scanner := bufio.NewScanner(os.Stdin)
+	ndx := 0
+	for {
+		fmt.Print("> ")
+		if !scanner.Scan() {
+			break
+		}
+
+		line := scanner.Text()
+		if line == "quit" {
+			break
+		}
+
+		echo(ndx, line)
+		ndx++
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("failed to read stdin: %v", err)
+	}
+	fmt.Printf("\nread %d lines\n", ndx)
+}
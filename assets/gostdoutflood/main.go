@@ -0,0 +1,67 @@
+// A program that writes interleaved stdout and stderr as fast as possible.
+// The total number of megabytes written may be passed as an argument
+// (default: 512, split evenly between stdout and stderr).
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const lineLen = 128
+
+//go:noinline
+func flood(name string, f *os.File, total int, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	w := bufio.NewWriterSize(f, 64*1024)
+	defer w.Flush()
+
+	line := make([]byte, lineLen)
+	written := 0
+	for ndx := 0; written < total; ndx++ {
+		header := fmt.Sprintf("%s %10d ", name, ndx)
+		n := copy(line, header)
+		for i := n; i < lineLen-1; i++ {
+			line[i] = 'a' + byte((ndx+i)%26)
+		}
+		line[lineLen-1] = '\n'
+
+		if _, err := w.Write(line); err != nil {
+			log.Fatalf("%s write failed: %v", name, err)
+		}
+		written += lineLen
+
+		// flush regularly so the streams actually interleave
+		if ndx%64 == 0 {
+			w.Flush() // sim:gostdoutflood stops here
+		}
+	}
+}
+
+func main() {
+	megabytes := 512
+	if len(os.Args) > 1 {
+		n, err := strconv.Atoi(os.Args[1])
+		if err != nil {
+			log.Fatalf("invalid size %q: %v", os.Args[1], err)
+		}
+		megabytes = n
+	}
+
+	start := time.Now()
+	perStream := megabytes * 1024 * 1024 / 2
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go flood("stdout", os.Stdout, perStream, &wg)
+	go flood("stderr", os.Stderr, perStream, &wg)
+	wg.Wait()
+
+	log.Printf("wrote %dMB in %s", megabytes, time.Since(start))
+}
@@ -0,0 +1,118 @@
+// A program with deliberately awkward shapes for step in/over/out: several
+// calls on one line, calls in conditions and loop headers, deferred calls
+// that run at function exit, calls in tail position, single-line functions,
+// method values, and closures called immediately
+package main
+
+import "fmt"
+
+type Counter struct {
+	N int
+}
+
+func (c *Counter) Inc() int { c.N++; return c.N }
+
+func one() int   { return 1 }
+func two() int   { return 2 }
+func three() int { return 3 }
+
+//go:noinline
+func add(a, b int) int {
+	return a + b
+}
+
+//go:noinline
+func isEven(n int) bool {
+	return n%2 == 0
+}
+
+//go:noinline
+func limit() int {
+	return 3
+}
+
+//go:noinline
+func multipleCallsOneLine() int {
+	return add(one(), two()) + add(three(), add(one(), one())) // sim:gostepedge stops here (many calls on one line)
+}
+
+//go:noinline
+func callsInConditions(n int) string {
+	if isEven(n) && add(n, 1) > 2 { // sim:gostepedge stops here (calls in a condition)
+		return "even"
+	} else if isEven(add(n, 1)) {
+		return "odd"
+	}
+	return "neither"
+}
+
+//go:noinline
+func callsInLoopHeader() int {
+	sum := 0
+	for ndx := one(); ndx <= limit(); ndx = add(ndx, one()) { // sim:gostepedge stops here (calls in a loop header)
+		sum += ndx
+	}
+	return sum
+}
+
+//go:noinline
+func deferred(label string) {
+	fmt.Println("deferred:", label)
+}
+
+//go:noinline
+func withDefers() (result int) {
+	defer deferred("first")
+	defer func() {
+		result *= 2 // sim:gostepedge stops here (deferred closure at function exit)
+	}()
+	defer deferred("second")
+
+	result = add(one(), two())
+	return result
+}
+
+//go:noinline
+func tailCall(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return tailCall(n - 1) // sim:gostepedge stops here (tail position call)
+}
+
+//go:noinline
+func singleLine() int { return add(two(), three()) }
+
+//go:noinline
+func immediateClosure(n int) int {
+	return func(x int) int { return x * n }(add(n, 1)) // sim:gostepedge stops here (immediately called closure)
+}
+
+//go:noinline
+func methodValues() int {
+	c := &Counter{}
+	inc := c.Inc
+	return inc() + inc() + c.Inc()
+}
+
+//go:noinline
+func panicAndRecover() (recovered bool) {
+	defer func() {
+		recovered = recover() != nil
+	}()
+	var m map[string]int
+	m["boom"] = add(one(), one()) // sim:gostepedge stops here (step over a panicking line)
+	return false
+}
+
+func main() {
+	fmt.Println(multipleCallsOneLine())
+	fmt.Println(callsInConditions(2), callsInConditions(3))
+	fmt.Println(callsInLoopHeader())
+	fmt.Println(withDefers())
+	fmt.Println(tailCall(3))
+	fmt.Println(singleLine())
+	fmt.Println(immediateClosure(4))
+	fmt.Println(methodValues())
+	fmt.Println(panicAndRecover())
+}
@@ -0,0 +1,52 @@
+// A program that exercises string rendering edge cases: multi-byte UTF-8,
+// combining characters, emoji, very long strings, byte/rune slice views,
+// and substrings that share backing memory with their parent
+package main
+
+import (
+	"log"
+	"strings"
+)
+
+func main() {
+	empty := ""
+	ascii := "hello, world"
+	multiByte := "héllo wörld ñ 菜单 Здравствуйте"
+
+	// each "letter" is a base rune followed by a combining mark
+	combining := "e\u0301 a\u0308 n\u0303"
+
+	// includes skin tone modifiers, ZWJ sequences, and flags
+	emoji := "😊👍🏽👨‍👩‍👧‍👦🇺🇸"
+
+	invalid := "bad \xff\xfe utf-8"
+	nulls := "before\x00after"
+
+	// a 1MB string to test truncation in the variable pane
+	long := strings.Repeat("0123456789abcdef", 1024*1024/16)
+
+	// substrings point in to the same backing memory as their parent
+	sub := long[16:32]
+	tail := multiByte[len(multiByte)-12:]
+
+	bytes := []byte(multiByte)
+	runes := []rune(multiByte)
+	emojiRunes := []rune(emoji)
+	longBytes := []byte(long)
+	subBytes := longBytes[100:116]
+
+	strs := []string{ascii, multiByte, combining, emoji, sub}
+
+	log.Printf("empty: %q", empty) // sim:gostrings stops here
+	log.Printf("ascii: %s", ascii)
+	log.Printf("multiByte: %s (bytes: %d, runes: %d)", multiByte, len(bytes), len(runes))
+	log.Printf("combining: %s", combining)
+	log.Printf("emoji: %s (runes: %d)", emoji, len(emojiRunes))
+	log.Printf("invalid: %q", invalid)
+	log.Printf("nulls: %q", nulls)
+	log.Printf("long: %d bytes", len(long))
+	log.Printf("sub: %s", sub)
+	log.Printf("tail: %s", tail)
+	log.Printf("subBytes: %s", subBytes)
+	log.Printf("strs: %d", len(strs))
+}
@@ -0,0 +1,78 @@
+// A program with maps keyed by comparable structs, fixed-size arrays, nested
+// combinations of the two, and interfaces holding composite values
+package main
+
+import "log"
+
+type Point struct {
+	X, Y int32
+}
+
+type Rect struct {
+	Min, Max Point
+}
+
+type Key struct {
+	Name    string
+	Version uint16
+	Enabled bool
+	Region  [2]byte
+}
+
+type Padded struct {
+	A byte
+	B int64
+	C byte
+}
+
+func main() {
+	byPoint := map[Point]string{
+		{X: 0, Y: 0}:   "origin",
+		{X: 1, Y: -1}:  "southeast",
+		{X: -5, Y: 10}: "far",
+	}
+
+	byRect := map[Rect]int{
+		{Min: Point{0, 0}, Max: Point{10, 10}}: 100,
+		{Min: Point{-1, -1}, Max: Point{1, 1}}: 4,
+	}
+
+	byKey := map[Key][]string{
+		{Name: "api", Version: 2, Enabled: true, Region: [2]byte{'u', 's'}}:  {"a", "b"},
+		{Name: "db", Version: 14, Enabled: false, Region: [2]byte{'e', 'u'}}: {"c"},
+	}
+
+	byArray := map[[4]byte]string{
+		{127, 0, 0, 1}: "localhost",
+		{10, 0, 0, 1}:  "gateway",
+		{0, 0, 0, 0}:   "any",
+	}
+
+	byNestedArray := map[[2][3]int]bool{
+		{{1, 2, 3}, {4, 5, 6}}: true,
+		{{0, 0, 0}, {0, 0, 0}}: false,
+	}
+
+	byPointArray := map[[3]Point]float64{
+		{{0, 0}, {1, 0}, {0, 1}}: 0.5,
+	}
+
+	// padding bytes inside the key aren't part of the key's identity
+	byPadded := map[Padded]int{
+		{A: 1, B: 2, C: 3}: 123,
+	}
+
+	byPtr := map[*Point]string{}
+	p := &Point{X: 7, Y: 8}
+	byPtr[p] = "pointer key"
+
+	byIface := map[any]string{
+		Point{X: 1, Y: 2}:   "struct in interface",
+		[2]string{"a", "b"}: "array in interface",
+		"plain":             "string",
+		42:                  "int",
+	}
+
+	log.Printf("point: %d, rect: %d, key: %d, array: %d, nested: %d, point array: %d, padded: %d, ptr: %d, iface: %d",
+		len(byPoint), len(byRect), len(byKey), len(byArray), len(byNestedArray), len(byPointArray), len(byPadded), len(byPtr), len(byIface)) // sim:gostructkeys stops here
+}
@@ -0,0 +1,11 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): go.mod requires go 1.24, the first release where maps are
+# implemented as Swiss tables. The Dockerfile installs Go 1.23, so building
+# this asset there relies on GOTOOLCHAIN=auto downloading go1.24 from the
+# module proxy, which requires network access at build time.
+#
+
+set -x
+go build -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
@@ -0,0 +1,3 @@
+module goswissmap
+
+go 1.24
@@ -0,0 +1,77 @@
+// A program with maps in various fill states, for toolchains that implement
+// maps as Swiss tables (Go 1.24+). Small maps are a single group with no
+// directory, larger maps have a directory of tables, and maps that have
+// had entries deleted contain tombstones.
+package main
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+)
+
+type Key struct {
+	A int32
+	B string
+}
+
+type Value struct {
+	Count int
+	Name  string
+	Data  [4]float64
+}
+
+func main() {
+	var nilMap map[string]int
+	empty := map[string]int{}
+
+	// fits in a single group of 8 slots
+	small := map[string]int{"one": 1, "two": 2, "three": 3}
+	full := make(map[int]int)
+	for ndx := range 8 {
+		full[ndx] = ndx * 10
+	}
+
+	// a single table with multiple groups
+	medium := make(map[int]string)
+	for ndx := range 200 {
+		medium[ndx] = fmt.Sprint(ndx)
+	}
+
+	// enough entries to split into several tables in the directory
+	large := make(map[int]int)
+	for ndx := range 10_000 {
+		large[ndx] = -ndx
+	}
+
+	// deleted entries leave tombstones behind
+	sparse := make(map[int]int)
+	for ndx := range 1000 {
+		sparse[ndx] = ndx
+	}
+	for ndx := range 1000 {
+		if ndx%3 != 0 {
+			delete(sparse, ndx)
+		}
+	}
+
+	// composite keys and elems that fit in abi.MapMaxKeyBytes and abi.MapMaxElemBytes
+	// (128 bytes each) are stored inline in their slots
+	structs := make(map[Key]Value)
+	for ndx := range 20 {
+		structs[Key{A: int32(ndx), B: fmt.Sprintf("k%d", ndx)}] = Value{Count: ndx, Name: "v", Data: [4]float64{float64(ndx)}}
+	}
+
+	// both the key and the elem are over the limit, so each slot holds pointers to them
+	big := map[[129]byte][256]byte{{1}: {2}, {3}: {4}}
+
+	// a map that's been cleared keeps its tables
+	cleared := make(map[int]int)
+	for ndx := range 100 {
+		cleared[ndx] = ndx
+	}
+	clear(cleared)
+
+	log.Printf("go: %s, nil: %d, empty: %d, small: %d, full: %d, medium: %d, large: %d, sparse: %d, structs: %d, big: %d, cleared: %d",
+		runtime.Version(), len(nilMap), len(empty), len(small), len(full), len(medium), len(large), len(sparse), len(structs), len(big), len(cleared)) // sim:goswissmap stops here
+}
@@ -0,0 +1,102 @@
+// A program that parks goroutines on contended synchronization primitives
+// (sync.Mutex, sync.RWMutex, sync.WaitGroup, sync.Cond, and sync/atomic
+// counters) so their internal state can be inspected
+package main
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const numWorkers = 4
+
+type Shared struct {
+	mu      sync.Mutex
+	rw      sync.RWMutex
+	wg      sync.WaitGroup
+	cond    *sync.Cond
+	ready   bool
+	counter atomic.Int64
+	flags   atomic.Uint32
+	value   atomic.Value
+	hits    int64
+}
+
+//go:noinline
+func holdMutex(s *Shared) {
+	defer s.wg.Done()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// all the other workers are now blocked in s.mu.Lock
+	time.Sleep(500 * time.Millisecond)
+	s.hits++ // sim:gosync stops here (mutex contended)
+}
+
+//go:noinline
+func holdWriteLock(s *Shared) {
+	defer s.wg.Done()
+
+	s.rw.Lock()
+	defer s.rw.Unlock()
+
+	// readers are now blocked in s.rw.RLock
+	time.Sleep(500 * time.Millisecond)
+	s.flags.Store(0xff) // sim:gosync stops here (rwmutex contended)
+}
+
+func worker(s *Shared, id int) {
+	defer s.wg.Done()
+
+	// wait for the main goroutine to broadcast
+	s.cond.L.Lock()
+	for !s.ready {
+		s.cond.Wait()
+	}
+	s.cond.L.Unlock()
+
+	s.mu.Lock()
+	s.hits++
+	s.mu.Unlock()
+
+	s.rw.RLock()
+	_ = s.flags.Load()
+	s.rw.RUnlock()
+
+	s.counter.Add(int64(id))
+}
+
+func main() {
+	s := &Shared{}
+	s.cond = sync.NewCond(&sync.Mutex{})
+	s.value.Store("initial")
+
+	for i := range numWorkers {
+		s.wg.Add(1)
+		go worker(s, i+1)
+	}
+
+	// workers are all parked in s.cond.Wait
+	time.Sleep(500 * time.Millisecond)
+	log.Printf("workers waiting on cond") // sim:gosync stops here (cond waiters)
+
+	// take the locks before waking everyone up so the workers pile up behind them
+	s.wg.Add(2)
+	go holdMutex(s)
+	go holdWriteLock(s)
+	time.Sleep(100 * time.Millisecond)
+
+	s.cond.L.Lock()
+	s.ready = true
+	s.cond.Broadcast()
+	s.cond.L.Unlock()
+
+	s.wg.Wait()
+	s.value.Store("done")
+
+	log.Printf("counter: %d, hits: %d, flags: %#x, value: %v",
+		s.counter.Load(), s.hits, s.flags.Load(), s.value.Load())
+}
@@ -0,0 +1,71 @@
+// A program that populates a sync.Map and reads it concurrently from several
+// goroutines, so entries are spread across both its read-only and dirty
+// internal maps (or, on newer Go versions, its internal hash trie)
+package main
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+type Session struct {
+	User  string
+	Hits  atomic.Int64
+	Attrs map[string]string
+}
+
+func main() {
+	var sessions sync.Map
+	for ndx := range 64 {
+		key := fmt.Sprintf("session-%02d", ndx)
+		sessions.Store(key, &Session{User: fmt.Sprintf("user%d", ndx), Attrs: map[string]string{"ndx": fmt.Sprint(ndx)}})
+	}
+
+	// mixed-type keys and values
+	var mixed sync.Map
+	mixed.Store(1, "one")
+	mixed.Store("two", 2)
+	mixed.Store(3.0, []int{3})
+
+	var wg sync.WaitGroup
+	var misses atomic.Int64
+	for reader := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ndx := range 1000 {
+				key := fmt.Sprintf("session-%02d", (ndx*7+reader)%80)
+				if v, ok := sessions.Load(key); ok {
+					v.(*Session).Hits.Add(1)
+				} else {
+					misses.Add(1)
+				}
+			}
+		}()
+	}
+
+	// writer adding new keys (which land in the dirty map) and deleting
+	// others while readers are active
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for ndx := 64; ndx < 80; ndx++ {
+			sessions.Store(fmt.Sprintf("session-%02d", ndx), &Session{User: "late"})
+		}
+		for ndx := 0; ndx < 8; ndx++ {
+			sessions.Delete(fmt.Sprintf("session-%02d", ndx))
+		}
+		sessions.LoadOrStore("session-00", &Session{User: "restored"})
+	}()
+	wg.Wait()
+
+	count := 0
+	sessions.Range(func(key, value any) bool {
+		count++
+		return true
+	})
+	one, _ := mixed.Load(1)
+	log.Printf("sessions: %d, misses: %d, mixed[1]: %v", count, misses.Load(), one) // sim:gosyncmap stops here
+}
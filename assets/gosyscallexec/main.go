@@ -0,0 +1,67 @@
+// A program with goroutines blocked in long raw syscalls made through the
+// syscall package, rather than parked in the netpoller, so they sit in the
+// runtime's _Gsyscall state: a read on a blocking pipe, nanosleep, and a
+// wait4 on a child process that's still running
+package main
+
+import (
+	"log"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+//go:noinline
+func blockedRead(fd int, wg *sync.WaitGroup) {
+	defer wg.Done()
+	buf := make([]byte, 16)
+	n, err := syscall.Read(fd, buf) // sim:gosyscallexec blocks here (read)
+	log.Printf("read %d bytes: %q, %v", n, buf[:n], err)
+}
+
+//go:noinline
+func blockedNanosleep(d time.Duration, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ts := syscall.NsecToTimespec(int64(d))
+	err := syscall.Nanosleep(&ts, nil) // sim:gosyscallexec blocks here (nanosleep)
+	log.Printf("nanosleep returned: %v", err)
+}
+
+//go:noinline
+func blockedWait(pid int, wg *sync.WaitGroup) {
+	defer wg.Done()
+	var status syscall.WaitStatus
+	_, err := syscall.Wait4(pid, &status, 0, nil) // sim:gosyscallexec blocks here (wait4)
+	log.Printf("child %d exited with %d: %v", pid, status.ExitStatus(), err)
+}
+
+func main() {
+	// syscall.Pipe fds are blocking and never registered with the netpoller,
+	// unlike os.Pipe
+	var fds [2]int
+	if err := syscall.Pipe(fds[:]); err != nil {
+		log.Fatalf("error creating pipe: %v", err)
+	}
+
+	pid, err := syscall.ForkExec("/bin/sleep", []string{"sleep", "2"}, &syscall.ProcAttr{Files: []uintptr{0, 1, 2}})
+	if err != nil {
+		log.Fatalf("error spawning child: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go blockedRead(fds[0], &wg)
+	go blockedNanosleep(2*time.Second, &wg)
+	go blockedWait(pid, &wg)
+
+	time.Sleep(time.Second)
+	log.Printf("goroutines blocked in syscalls (pid: %d)", os.Getpid()) // sim:gosyscallexec stops here
+
+	time.Sleep(time.Second)
+	syscall.Write(fds[1], []byte("wake up"))
+	wg.Wait()
+
+	syscall.Close(fds[0])
+	syscall.Close(fds[1])
+}
@@ -0,0 +1,119 @@
+// A long-running TCP echo server that handles every connection in its own
+// goroutine, with a built-in load generator that continuously opens many
+// short-lived connections. The listen address may be passed as an argument
+// (default: 127.0.0.1:8422).
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+type Conn struct {
+	ID       int64
+	Remote   string
+	Opened   time.Time
+	Messages int
+	Bytes    int
+}
+
+var (
+	nextID   atomic.Int64
+	active   atomic.Int64
+	finished atomic.Int64
+)
+
+//go:noinline
+func handle(nc net.Conn) {
+	defer nc.Close()
+
+	active.Add(1)
+	defer active.Add(-1)
+	defer finished.Add(1)
+
+	c := &Conn{
+		ID:     nextID.Add(1),
+		Remote: nc.RemoteAddr().String(),
+		Opened: time.Now(),
+	}
+
+	r := bufio.NewReader(nc)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("conn %d: read failed: %v", c.ID, err)
+			}
+			return
+		}
+
+		c.Messages++
+		c.Bytes += len(line)
+		reply := fmt.Sprintf("echo %d/%d: %s", c.ID, c.Messages, line) // sim:gotcpserver stops here
+		if _, err := io.WriteString(nc, reply); err != nil {
+			log.Printf("conn %d: write failed: %v", c.ID, err)
+			return
+		}
+	}
+}
+
+func client(addr string, id int) {
+	nc, err := net.Dial("tcp", addr)
+	if err != nil {
+		log.Printf("client %d: dial failed: %v", id, err)
+		return
+	}
+	defer nc.Close()
+
+	r := bufio.NewReader(nc)
+	for msg := range 1 + id%5 {
+		if _, err := fmt.Fprintf(nc, "client %d message %d\n", id, msg); err != nil {
+			return
+		}
+		if _, err := r.ReadString('\n'); err != nil {
+			return
+		}
+		time.Sleep(time.Duration(id%50) * time.Millisecond)
+	}
+}
+
+func loadGenerator(addr string) {
+	for id := 0; ; id++ {
+		go client(addr, id)
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func main() {
+	addr := "127.0.0.1:8422"
+	if len(os.Args) > 1 {
+		addr = os.Args[1]
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", addr, err)
+	}
+	log.Printf("gotcpserver (pid: %d) listening on %s", os.Getpid(), ln.Addr())
+
+	go loadGenerator(ln.Addr().String())
+	go func() {
+		for range time.Tick(2 * time.Second) {
+			log.Printf("active connections: %d, finished: %d", active.Load(), finished.Load())
+		}
+	}()
+
+	for {
+		nc, err := ln.Accept()
+		if err != nil {
+			log.Fatalf("accept failed: %v", err)
+		}
+		go handle(nc)
+	}
+}
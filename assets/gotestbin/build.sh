@@ -0,0 +1,9 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): this asset is a test binary rather than a program, so there's no
+# main.go. Run it with the usual test flags, i.e. ./out -test.run 'TestParse/negative' -test.v
+#
+
+set -x
+go test -c -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
@@ -0,0 +1,59 @@
+// Package calc is a small expression evaluator whose tests are compiled into
+// a standalone test binary with `go test -c`
+package calc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Op byte
+
+const (
+	Add Op = '+'
+	Sub Op = '-'
+	Mul Op = '*'
+	Div Op = '/'
+)
+
+type Expr struct {
+	Left, Right int
+	Op          Op
+}
+
+//go:noinline
+func Parse(s string) (Expr, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 3 || len(fields[1]) != 1 {
+		return Expr{}, fmt.Errorf("invalid expression: %q", s)
+	}
+
+	left, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return Expr{}, fmt.Errorf("invalid left operand: %w", err)
+	}
+	right, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return Expr{}, fmt.Errorf("invalid right operand: %w", err)
+	}
+	return Expr{Left: left, Right: right, Op: Op(fields[1][0])}, nil // sim:gotestbin stops here (Parse)
+}
+
+//go:noinline
+func (e Expr) Eval() (int, error) {
+	switch e.Op {
+	case Add:
+		return e.Left + e.Right, nil
+	case Sub:
+		return e.Left - e.Right, nil
+	case Mul:
+		return e.Left * e.Right, nil
+	case Div:
+		if e.Right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return e.Left / e.Right, nil
+	}
+	return 0, fmt.Errorf("unknown operator: %c", e.Op)
+}
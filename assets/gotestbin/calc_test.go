@@ -0,0 +1,79 @@
+package calc
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  Expr
+		err   bool
+	}{
+		{name: "add", input: "1 + 2", want: Expr{Left: 1, Right: 2, Op: Add}},
+		{name: "negative", input: "-4 * 5", want: Expr{Left: -4, Right: 5, Op: Mul}},
+		{name: "missing operand", input: "1 +", err: true},
+		{name: "bad operand", input: "x - 1", err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Parse(c.input) // sim:gotestbin stops here (table-driven subtest)
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected an error parsing %q", c.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", c.input, err)
+			}
+			if got != c.want {
+				t.Fatalf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	t.Run("arithmetic", func(t *testing.T) {
+		for input, want := range map[string]int{"1 + 2": 3, "10 - 4": 6, "3 * 3": 9, "9 / 2": 4} {
+			e, err := Parse(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := e.Eval()
+			if err != nil || got != want {
+				t.Errorf("%s: got %d (%v), want %d", input, got, err, want)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Run("divide by zero", func(t *testing.T) {
+			_, err := Expr{Left: 1, Right: 0, Op: Div}.Eval() // sim:gotestbin stops here (nested subtest)
+			if err == nil {
+				t.Fatal("expected a division by zero error")
+			}
+		})
+		t.Run("unknown operator", func(t *testing.T) {
+			if _, err := (Expr{Op: '%'}).Eval(); err == nil {
+				t.Fatal("expected an unknown operator error")
+			}
+		})
+	})
+}
+
+func TestHelper(t *testing.T) {
+	mustParse := func(t *testing.T, s string) Expr {
+		t.Helper()
+		e, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+
+	if e := mustParse(t, "7 - 7"); e.Op != Sub {
+		t.Fatalf("got %c, want %c", e.Op, Sub)
+	}
+}
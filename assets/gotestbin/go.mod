@@ -0,0 +1,3 @@
+module gotestbin
+
+go 1.23
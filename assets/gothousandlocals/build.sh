@@ -0,0 +1,5 @@
+#!/usr/bin/env bash
+
+set -x
+go run generate.go -sizes ${SIZES:-1000,5000,10000}
+go build -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
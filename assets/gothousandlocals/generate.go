@@ -0,0 +1,95 @@
+//go:build ignore
+
+// Generates the functions that make up the gothousandlocals asset. Each
+// function declares the given number of local variables, cycling through a
+// mix of types, and every one of them is still live at the function's
+// breakpoint.
+//
+//	$ go run generate.go -sizes 1000,5000,10000
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const generated = "zz_generated.go"
+
+// each kind is a declaration and the expression that folds it in to the
+// function's checksum after the breakpoint
+var kinds = []struct {
+	decl string
+	use  string
+}{
+	{decl: "%s := n + %d", use: "sum += %s"},
+	{decl: "%s := float64(n) * %d.5", use: "sum += int(%s)"},
+	{decl: "%s := \"local-%d\"", use: "sum += len(%s)"},
+	{decl: "%s := n%%2 == %d%%2", use: "if %s { sum++ }"},
+	{decl: "%s := Point{X: n, Y: %d}", use: "sum += %s.X + %s.Y"},
+	{decl: "%s := []int{n, %d}", use: "sum += len(%s)"},
+	{decl: "%s := &Point{X: %d}", use: "sum += %s.X"},
+	{decl: "%s := uint8(n + %d)", use: "sum += int(%s)"},
+	{decl: "%s := [2]int16{int16(n), %d}", use: "sum += int(%s[1])"},
+	{decl: "%s := map[int]int{n: %d}", use: "sum += len(%s)"},
+}
+
+func main() {
+	sizesFlag := flag.String("sizes", "1000,5000,10000", "comma-separated numbers of locals to generate functions for")
+	flag.Parse()
+
+	var sizes []int
+	for _, s := range strings.Split(*sizesFlag, ",") {
+		size, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			log.Fatalf("invalid size %q: %v", s, err)
+		}
+		sizes = append(sizes, size)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by generate.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package main\n\n")
+
+	for _, size := range sizes {
+		fmt.Fprintf(&buf, "//go:noinline\nfunc locals%d(n int) int {\n", size)
+		for ndx := range size {
+			kind := kinds[ndx%len(kinds)]
+			name := fmt.Sprintf("l%d", ndx)
+			fmt.Fprintf(&buf, "\t"+kind.decl+"\n", name, ndx)
+		}
+
+		fmt.Fprintf(&buf, "\n\tsum := 0 // sim:gothousandlocals stops here (%d locals)\n", size)
+		for ndx := range size {
+			kind := kinds[ndx%len(kinds)]
+			name := fmt.Sprintf("l%d", ndx)
+			args := make([]any, strings.Count(kind.use, "%s"))
+			for arg := range args {
+				args[arg] = name
+			}
+			fmt.Fprintf(&buf, "\t"+kind.use+"\n", args...)
+		}
+		fmt.Fprintf(&buf, "\treturn sum\n}\n\n")
+	}
+
+	fmt.Fprintf(&buf, "func runAll(n int) []int {\n\treturn []int{\n")
+	for _, size := range sizes {
+		fmt.Fprintf(&buf, "\t\tlocals%d(n),\n", size)
+	}
+	fmt.Fprintf(&buf, "\t}\n}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format %s: %v", generated, err)
+	}
+	if err := os.WriteFile(generated, formatted, 0o644); err != nil {
+		log.Fatalf("failed to write %s: %v", generated, err)
+	}
+
+	log.Printf("generated functions with %v locals", sizes)
+}
@@ -0,0 +1,3 @@
+module gothousandlocals
+
+go 1.23
@@ -0,0 +1,15 @@
+// A program with generated functions that each declare thousands of local
+// variables of varied types, all live at a breakpoint. The functions are
+// generated by build.sh (see generate.go) rather than checked in.
+package main
+
+import "log"
+
+type Point struct {
+	X, Y int
+}
+
+func main() {
+	sums := runAll(3)
+	log.Printf("sums: %v", sums) // sim:gothousandlocals stops here
+}
@@ -0,0 +1,82 @@
+// A program with active time.Timers and time.Tickers, plus time.AfterFunc
+// callbacks that fire on a schedule (each callback runs in its own goroutine)
+package main
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Event struct {
+	Name  string
+	Fired time.Time
+	Count int64
+}
+
+var fired atomic.Int64
+
+//go:noinline
+func onTimer(name string, events chan<- Event) {
+	n := fired.Add(1)
+	ev := Event{Name: name, Fired: time.Now(), Count: n}
+	events <- ev // sim:gotimers stops here (inside an AfterFunc callback)
+}
+
+func main() {
+	start := time.Now()
+	events := make(chan Event, 64)
+
+	// AfterFunc callbacks at staggered delays
+	var wg sync.WaitGroup
+	var callbacks []*time.Timer
+	for ndx, delay := range []time.Duration{100, 250, 500, 750, 1000} {
+		wg.Add(1)
+		name := "after-" + (delay * time.Millisecond).String()
+		t := time.AfterFunc(delay*time.Millisecond, func() {
+			defer wg.Done()
+			onTimer(name, events)
+		})
+		callbacks = append(callbacks, t)
+
+		// stop one of them before it fires
+		if ndx == 3 {
+			if t.Stop() {
+				wg.Done()
+			}
+		}
+	}
+
+	// a timer that's reset before it fires, and one that's never read from
+	resetTimer := time.NewTimer(time.Hour)
+	resetTimer.Reset(300 * time.Millisecond)
+	idleTimer := time.NewTimer(time.Hour)
+	defer idleTimer.Stop()
+
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(1500 * time.Millisecond)
+
+	ticks := 0
+loop:
+	for {
+		select {
+		case <-ticker.C:
+			ticks++
+			if ticks == 3 {
+				// slow the ticker down part-way through
+				ticker.Reset(400 * time.Millisecond)
+			}
+		case <-resetTimer.C:
+			log.Printf("reset timer fired after %s", time.Since(start).Round(time.Millisecond)) // sim:gotimers stops here
+		case ev := <-events:
+			log.Printf("%s fired after %s (count: %d)", ev.Name, ev.Fired.Sub(start).Round(time.Millisecond), ev.Count)
+		case <-timeout:
+			break loop
+		}
+	}
+
+	wg.Wait()
+	log.Printf("ticks: %d, callbacks fired: %d/%d", ticks, fired.Load(), len(callbacks))
+}
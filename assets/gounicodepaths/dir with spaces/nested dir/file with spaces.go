@@ -0,0 +1,12 @@
+// Package spaces lives several directories deep, where every path component
+// contains a space
+package spaces
+
+//go:noinline
+func Sum(vals ...int) int {
+	sum := 0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum // sim:gounicodepaths stops here (dir with spaces/nested dir/file with spaces.go)
+}
@@ -0,0 +1,3 @@
+module gounicodepaths/spaces
+
+go 1.23
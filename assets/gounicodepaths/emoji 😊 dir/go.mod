@@ -0,0 +1,3 @@
+module gounicodepaths/emoji
+
+go 1.23
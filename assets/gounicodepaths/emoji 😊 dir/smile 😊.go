@@ -0,0 +1,10 @@
+// Package emoji lives in a directory and file whose names contain emoji
+package emoji
+
+import "strings"
+
+//go:noinline
+func Smile(n int) string {
+	res := strings.Repeat("😊", n)
+	return res // sim:gounicodepaths stops here (emoji 😊 dir/smile 😊.go)
+}
@@ -0,0 +1,16 @@
+module gounicodepaths
+
+go 1.23
+
+require (
+	gounicodepaths/emoji v0.0.0
+	gounicodepaths/menu v0.0.0
+	gounicodepaths/spaces v0.0.0
+)
+
+// import paths must be ASCII, but the directories they resolve to don't have to be
+replace gounicodepaths/menu => "./菜单 menu"
+
+replace gounicodepaths/emoji => "./emoji 😊 dir"
+
+replace gounicodepaths/spaces => "./dir with spaces/nested dir"
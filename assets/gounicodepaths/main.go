@@ -0,0 +1,20 @@
+// A program whose packages live under directories (and in files) with
+// spaces, emoji, and CJK characters in their names. Go requires import paths
+// to be ASCII, so go.mod maps each package's import path to its directory with
+// a replace directive.
+package main
+
+import (
+	"log"
+
+	"gounicodepaths/emoji"
+	"gounicodepaths/menu"
+	"gounicodepaths/spaces"
+)
+
+func main() {
+	log.Printf("menu total: %d", menu.Total())
+	log.Printf("menu[1]: %s", menu.Describe(1))
+	log.Printf("smile: %s", emoji.Smile(3))
+	log.Printf("sum: %d", spaces.Sum(1, 2, 3))
+}
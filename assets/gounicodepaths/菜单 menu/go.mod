@@ -0,0 +1,3 @@
+module gounicodepaths/menu
+
+go 1.23
@@ -0,0 +1,31 @@
+// Package menu lives in a directory and file whose names contain CJK
+// characters and spaces
+package menu
+
+import "fmt"
+
+type Item struct {
+	Name  string
+	Price int
+}
+
+var Items = []Item{
+	{Name: "饺子", Price: 12},
+	{Name: "拉面", Price: 15},
+	{Name: "包子", Price: 8},
+}
+
+//go:noinline
+func Total() int {
+	total := 0
+	for _, item := range Items {
+		total += item.Price
+	}
+	return total // sim:gounicodepaths stops here (菜单 menu/菜单 items.go)
+}
+
+//go:noinline
+func Describe(ndx int) string {
+	item := Items[ndx]
+	return fmt.Sprintf("%s: ¥%d", item.Name, item.Price)
+}
@@ -0,0 +1,63 @@
+// A program that converts between typed pointers, uintptrs, and unsafe.Pointers,
+// including pointers in to the middle of an object and addresses that are not
+// safe to dereference
+package main
+
+import (
+	"log"
+	"unsafe"
+)
+
+type Header struct {
+	Magic   uint32
+	Version uint16
+	Flags   uint16
+	Payload [16]byte
+}
+
+type Holder struct {
+	Raw  unsafe.Pointer
+	Addr uintptr
+}
+
+func main() {
+	h := &Header{
+		Magic:   0xfeedface,
+		Version: 3,
+		Flags:   0x8001,
+	}
+	copy(h.Payload[:], "unsafe payload!!")
+
+	// plain conversions
+	p := unsafe.Pointer(h)
+	addr := uintptr(p)
+	back := (*Header)(p)
+
+	// pointers to the middle of the object
+	versionPtr := (*uint16)(unsafe.Add(p, unsafe.Offsetof(h.Version)))
+	payloadPtr := (*byte)(unsafe.Pointer(uintptr(p) + unsafe.Offsetof(h.Payload) + 7))
+	midAddr := addr + unsafe.Offsetof(h.Payload)
+
+	// reinterpret the same memory as a different type
+	asWords := (*[6]uint32)(p)
+
+	// addresses that must never be followed by the debugger
+	var nilPtr unsafe.Pointer
+	garbage := uintptr(0xdeadbeef)
+	misaligned := unsafe.Pointer(uintptr(p) + 1)
+
+	holder := Holder{
+		Raw:  p,
+		Addr: addr,
+	}
+
+	slice := unsafe.Slice(&h.Payload[0], len(h.Payload))
+	str := unsafe.String(&h.Payload[0], 6)
+
+	log.Printf("p: %p, addr: %#x, back.Magic: %#x", p, addr, back.Magic) // sim:gounsafe stops here
+	log.Printf("versionPtr: %d, payloadPtr: %c, midAddr: %#x", *versionPtr, *payloadPtr, midAddr)
+	log.Printf("asWords: %#x", *asWords)
+	log.Printf("nilPtr: %v, garbage: %#x, misaligned: %p", nilPtr, garbage, misaligned)
+	log.Printf("holder: %+v", holder)
+	log.Printf("slice: %s, str: %s", slice, str)
+}
@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+set -x
+go build -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} main.go
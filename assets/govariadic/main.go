@@ -0,0 +1,71 @@
+// A program that calls variadic functions with zero arguments, several
+// arguments, and spread slices. The ...T parameter is a slice under the hood.
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+type Option struct {
+	Key   string
+	Value any
+}
+
+//go:noinline
+func sum(nums ...int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total // sim:govariadic stops here
+}
+
+//go:noinline
+func join(sep string, parts ...string) string {
+	res := strings.Join(parts, sep)
+	return res // sim:govariadic stops here
+}
+
+//go:noinline
+func configure(name string, opts ...Option) string {
+	var sb strings.Builder
+	sb.WriteString(name)
+	for _, opt := range opts {
+		fmt.Fprintf(&sb, " %s=%v", opt.Key, opt.Value)
+	}
+	return sb.String() // sim:govariadic stops here
+}
+
+//go:noinline
+func anything(vals ...any) int {
+	count := len(vals)
+	return count // sim:govariadic stops here
+}
+
+func main() {
+	// zero arguments: the slice is nil
+	log.Printf("sum(): %d", sum())
+
+	// several arguments: the compiler builds a backing array
+	log.Printf("sum(1, 2, 3): %d", sum(1, 2, 3))
+
+	// spread slice: the callee aliases the caller's slice
+	nums := []int{10, 20, 30, 40}
+	log.Printf("sum(nums...): %d", sum(nums...))
+	log.Printf("sum(nums[1:3]...): %d", sum(nums[1:3]...))
+
+	// empty (non-nil) spread
+	log.Printf("sum([]int{}...): %d", sum([]int{}...))
+
+	log.Printf("join: %s", join(", ", "a", "b", "c"))
+	log.Printf("join: %s", join("-"))
+
+	opts := []Option{{"verbose", true}, {"level", 3}}
+	log.Printf("configure: %s", configure("server", opts...))
+	log.Printf("configure: %s", configure("client", Option{"retries", 5}))
+
+	log.Printf("anything: %d", anything(1, "two", 3.0, nil, []int{4}))
+	log.Printf("anything: %d", anything(nil))
+}
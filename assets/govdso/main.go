@@ -0,0 +1,77 @@
+// A program that calls clock_gettime and gettimeofday in tight loops, both
+// through the vDSO (which the runtime calls on the system stack) and through
+// raw syscalls, so that stopping it is likely to land in or just after vDSO
+// code that has no file-backed DWARF. It runs forever unless the first
+// argument is "once":
+//
+//	$ ./out
+//	$ ./out once
+package main
+
+import (
+	"log"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+//go:noinline
+func hammerNow(iterations int) time.Duration {
+	start := time.Now()
+	var last time.Time
+	for range iterations {
+		last = time.Now() // sim:govdso stops here (time.Now via vDSO clock_gettime)
+	}
+	return last.Sub(start)
+}
+
+//go:noinline
+func hammerGettimeofday(iterations int) int64 {
+	var tv syscall.Timeval
+	var total int64
+	for range iterations {
+		syscall.Gettimeofday(&tv) // sim:govdso stops here (gettimeofday)
+		total += tv.Usec
+	}
+	return total
+}
+
+//go:noinline
+func hammerClockGettime(iterations int) int64 {
+	var ts syscall.Timespec
+	var total int64
+	for range iterations {
+		// a raw syscall rather than the vDSO, for comparison
+		syscall.Syscall(syscall.SYS_CLOCK_GETTIME, 1, uintptr(unsafe.Pointer(&ts)), 0)
+		total += ts.Nsec
+	}
+	return total
+}
+
+func main() {
+	log.Printf("pid: %d", os.Getpid())
+
+	for round := 0; ; round++ {
+		var wg sync.WaitGroup
+		var now time.Duration
+		var tod int64
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			now = hammerNow(5_000_000)
+		}()
+		go func() {
+			defer wg.Done()
+			tod = hammerGettimeofday(1_000_000)
+		}()
+		raw := hammerClockGettime(100_000)
+		wg.Wait()
+
+		log.Printf("round %d: now: %s, gettimeofday: %d, raw: %d", round, now, tod, raw) // sim:govdso stops here
+		if len(os.Args) > 1 && os.Args[1] == "once" {
+			return
+		}
+	}
+}
@@ -0,0 +1,9 @@
+#!/usr/bin/env bash
+
+#
+# @NOTE (jrc): the dependencies are checked in under vendor/ and don't exist
+# upstream, so always build in vendor mode (and never try to download them)
+#
+
+set -x
+go build -mod=vendor -gcflags="${GCFLAGS:-all=-N -l}" -o ${OUT:-out} .
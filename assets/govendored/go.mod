@@ -0,0 +1,8 @@
+module govendored
+
+go 1.23
+
+require (
+	github.com/example-org/platform-libs/v3 v3.2.1
+	gopkg.in/example/retry.v2 v2.0.4
+)
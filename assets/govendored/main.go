@@ -0,0 +1,34 @@
+// A program whose dependencies are vendored third-party modules with deep
+// import paths, so its compile units' file paths live under vendor/
+package main
+
+import (
+	"errors"
+	"log"
+
+	invoicing "github.com/example-org/platform-libs/v3/pkg/services/billing/invoicing/v1alpha1"
+	"github.com/example-org/platform-libs/v3/pkg/telemetry/exporters/otlp/transport"
+	"gopkg.in/example/retry.v2"
+)
+
+func main() {
+	tracer := transport.NewClient("localhost:4317")
+	inv := &invoicing.Invoice{
+		Customer: "acme",
+		Items: []invoicing.LineItem{
+			{SKU: "compute-hours", Quantity: 12, Cents: 450},
+			{SKU: "storage-gb", Quantity: 500, Cents: 2},
+		},
+	}
+
+	var total int64
+	err := retry.Policy{Attempts: 3}.Do(func(attempt int) error {
+		if attempt == 0 {
+			return errors.New("transient failure")
+		}
+		total = inv.Total(tracer)
+		return nil
+	})
+
+	log.Printf("total: %d, err: %v, spans sent: %d", total, err, tracer.Sent()) // sim:govendored stops here
+}
@@ -0,0 +1,68 @@
+// A program that writes to specific variables on a precise, known schedule
+// so hardware watchpoint hits can be asserted against:
+//
+//	everyFifth   global, written when iteration % 5 == 0 (0, 5, 10, ...)
+//	local        local, written every iteration (value == iteration)
+//	aliased      local, written every third iteration via a pointer alias
+//	fromWorker   global, written by a second goroutine exactly 10 times
+//	readOnly     global, read every iteration but never written after init
+package main
+
+import (
+	"log"
+	"sync"
+)
+
+const iterations = 30
+
+var (
+	everyFifth int64
+	fromWorker int64
+	readOnly   = int64(42)
+)
+
+//go:noinline
+func writeThroughAlias(p *int64, val int64) {
+	*p = val // sim:gowatch stops here (alias write)
+}
+
+func worker(wg *sync.WaitGroup, start <-chan struct{}) {
+	defer wg.Done()
+
+	<-start
+	for ndx := range int64(10) {
+		fromWorker = 1000 + ndx // sim:gowatch stops here (second goroutine write)
+	}
+}
+
+func main() {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	wg.Add(1)
+	go worker(&wg, start)
+
+	local := int64(-1)
+	aliased := int64(-1)
+	alias := &aliased
+	sum := int64(0)
+
+	for ndx := range int64(iterations) {
+		local = ndx
+
+		if ndx%5 == 0 {
+			everyFifth = ndx // sim:gowatch stops here (every 5th iteration)
+		}
+		if ndx%3 == 0 {
+			writeThroughAlias(alias, ndx*10)
+		}
+
+		sum += readOnly + local
+	}
+
+	// the worker runs only after the main loop is finished, so the order of writes is deterministic
+	close(start)
+	wg.Wait()
+
+	log.Printf("local: %d, aliased: %d, everyFifth: %d, fromWorker: %d, readOnly: %d, sum: %d",
+		local, aliased, everyFifth, fromWorker, readOnly, sum)
+}
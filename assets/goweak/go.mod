@@ -0,0 +1,3 @@
+module goweak
+
+go 1.24
@@ -0,0 +1,87 @@
+// A program with weak pointers (package weak) and cleanup callbacks
+// (runtime.AddCleanup), stopping both while the targets are alive and after
+// they've been collected and their weak pointers have gone nil
+package main
+
+import (
+	"log"
+	"runtime"
+	"sync"
+	"time"
+	"weak"
+)
+
+type Blob struct {
+	ID   int
+	Data []byte
+}
+
+type Cache struct {
+	mu      sync.Mutex
+	entries map[int]weak.Pointer[Blob]
+}
+
+//go:noinline
+func (c *Cache) Put(b *Blob) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[b.ID] = weak.Make(b)
+}
+
+//go:noinline
+func (c *Cache) Live() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	live := 0
+	for _, wp := range c.entries {
+		if wp.Value() != nil {
+			live++
+		}
+	}
+	return live
+}
+
+var cleaned = make(chan int, 16)
+
+//go:noinline
+func onCleanup(id int) {
+	cleaned <- id // sim:goweak stops here (cleanup callback)
+}
+
+func main() {
+	cache := &Cache{entries: map[int]weak.Pointer[Blob]{}}
+
+	// kept alive with a strong reference for the duration of main
+	kept := &Blob{ID: 0, Data: make([]byte, 1024)}
+	cache.Put(kept)
+	runtime.AddCleanup(kept, onCleanup, kept.ID)
+
+	// only weakly referenced, so these are collected on the next GC
+	for id := 1; id <= 4; id++ {
+		b := &Blob{ID: id, Data: make([]byte, 1024*id)}
+		cache.Put(b)
+		runtime.AddCleanup(b, onCleanup, b.ID)
+	}
+
+	keptWeak := cache.entries[0]
+	goneWeak := cache.entries[1]
+	log.Printf("before gc: live: %d, kept: %v, gone: %v", cache.Live(), keptWeak.Value() != nil, goneWeak.Value() != nil) // sim:goweak stops here (before collection)
+
+	runtime.GC()
+	runtime.GC()
+
+	var ids []int
+	timeout := time.After(2 * time.Second)
+	for len(ids) < 4 {
+		select {
+		case id := <-cleaned:
+			ids = append(ids, id)
+		case <-timeout:
+			log.Fatalf("timed out waiting for cleanups, got: %v", ids)
+		}
+	}
+
+	log.Printf("after gc: live: %d, kept: %v, gone: %v, cleaned: %v", cache.Live(), keptWeak.Value() != nil, goneWeak.Value() != nil, ids) // sim:goweak stops here (after collection)
+	runtime.KeepAlive(kept)
+}
@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+set -x
+rm -f out out_* testdata/output.txt
@@ -0,0 +1,64 @@
+// A program that prints its working directory, then reads and writes files
+// via paths relative to it. It expects to be launched from its own asset
+// directory (where testdata/input.txt exists), and reports clearly when the
+// working directory is wrong.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	inputPath  = "testdata/input.txt"
+	outputPath = "testdata/output.txt"
+)
+
+//go:noinline
+func readLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err // sim:goworkdir stops here (wrong working directory)
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+func main() {
+	cwd, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("error getting working directory: %v", err)
+	}
+	log.Printf("cwd: %s", cwd)
+
+	abs, _ := filepath.Abs(inputPath)
+	lines, err := readLines(inputPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Fatalf("%s not found (resolved to %s), is the working directory set to the asset directory?", inputPath, abs)
+	}
+	if err != nil {
+		log.Fatalf("error reading %s: %v", abs, err)
+	}
+
+	upper := make([]string, 0, len(lines))
+	for _, line := range lines {
+		upper = append(upper, strings.ToUpper(line))
+	}
+
+	out := strings.Join(upper, "\n") + "\n"
+	if err := os.WriteFile(outputPath, []byte(out), 0o644); err != nil {
+		log.Fatalf("error writing %s: %v", outputPath, err)
+	}
+	log.Printf("wrote %d lines from %s to %s", len(upper), inputPath, outputPath) // sim:goworkdir stops here
+}
@@ -0,0 +1,61 @@
+// A program with zero-sized types and empty aggregates, both on their own and
+// embedded inside larger structs
+package main
+
+import (
+	"log"
+	"unsafe"
+)
+
+type Empty struct{}
+
+type EmptyNested struct {
+	A Empty
+	B [0]int
+	C struct{}
+}
+
+// zero-sized fields at the start, middle, and end of a struct. A trailing
+// zero-sized field causes the compiler to add padding so that taking its
+// address doesn't point past the end of the object.
+type Mixed struct {
+	Start  Empty
+	X      int32
+	Middle [0]string
+	Y      int64
+	End    struct{}
+}
+
+type Set map[string]struct{}
+
+func main() {
+	var e Empty
+	var arr [0]int
+	var arrOfEmpty [10]Empty
+	nested := EmptyNested{}
+	mixed := Mixed{X: 1, Y: 2}
+
+	set := Set{"a": {}, "b": {}, "c": {}}
+	emptyMap := map[Empty]int{{}: 42}
+
+	done := make(chan struct{}, 3)
+	done <- struct{}{}
+	done <- struct{}{}
+	var unbuffered chan struct{}
+
+	emptySlice := []Empty{{}, {}, {}}
+	zeroLen := make([]int, 0, 8)
+	var nilSlice []int
+
+	// all pointers to zero-sized values may be equal (they often point at runtime.zerobase)
+	p1 := &e
+	p2 := &Empty{}
+	var iface any = Empty{}
+
+	log.Printf("e: %v, arr: %v, arrOfEmpty: %v", e, arr, arrOfEmpty) // sim:gozerosized stops here
+	log.Printf("nested: %v (size %d), mixed: %v (size %d)", nested, unsafe.Sizeof(nested), mixed, unsafe.Sizeof(mixed))
+	log.Printf("set: %v, emptyMap: %v", set, emptyMap)
+	log.Printf("done: %d/%d, unbuffered: %v", len(done), cap(done), unbuffered)
+	log.Printf("emptySlice: %v, zeroLen: %v, nilSlice: %v", emptySlice, zeroLen, nilSlice)
+	log.Printf("p1: %p, p2: %p, iface: %v", p1, p2, iface)
+}
@@ -703,6 +703,35 @@ test "load ELF files" {
             .path = "./assets/goloop/out",
             .cu_lang = .DW_LANG_Go,
         },
+        .{
+            .path = "./assets/goloop/out_dynamic",
+            .cu_lang = .DW_LANG_Go,
+        },
+        .{
+            .path = "./assets/goloop/out_pie_static",
+            .pie = true,
+            .cu_lang = .DW_LANG_Go,
+        },
+        .{
+            // DWARF 4 regardless of the toolchain's default (out_dwarf5 needs the dwarf5 experiment)
+            .path = "./assets/goloop/out_dwarf4",
+            .cu_lang = .DW_LANG_Go,
+        },
+        .{
+            // cgo: the first CU is still Go, and the C CUs are linked in after it
+            .path = "./assets/gocgocallback/out_dynamic",
+            .cu_lang = .DW_LANG_Go,
+        },
+        .{
+            .path = "./assets/gocgocallback/out_pie_dynamic",
+            .pie = true,
+            .cu_lang = .DW_LANG_Go,
+        },
+        .{
+            // built with `go test -c`
+            .path = "./assets/gotestbin/out",
+            .cu_lang = .DW_LANG_Go,
+        },
         .{
             .path = "./assets/zigloop/out",
             .cu_lang = .DW_LANG_Zig,
@@ -729,6 +758,29 @@ test "load ELF files" {
         });
     }
 
+    // every Go asset's build with default optimizations from assets/go_variants.sh
+    var opt_paths = ArenaAllocator.init(t.allocator);
+    defer opt_paths.deinit();
+
+    var assets_dir = try fs.cwd().openDir("./assets", .{ .iterate = true });
+    defer assets_dir.close();
+
+    var assets_it = assets_dir.iterate();
+    while (try assets_it.next()) |entry| {
+        if (entry.kind != .directory) continue;
+
+        const path = try std.fmt.allocPrint(opt_paths.allocator(), "./assets/{s}/out_opt", .{entry.name});
+        fs.cwd().access(path, .{}) catch |err| switch (err) {
+            error.FileNotFound => continue,
+            else => return err,
+        };
+
+        try cases.append(.{
+            .path = path,
+            .cu_lang = .DW_LANG_Go,
+        });
+    }
+
     for (cases.items, 0..) |case, ndx| {
         defer log.flush();
 